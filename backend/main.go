@@ -50,7 +50,7 @@ func init() {
 }
 
 func main() {
-	http.HandleFunc("/api/", routes.APIRouter(firestoreClient, frontendURL))
+	http.HandleFunc("/api/", routes.APIRouter(firestoreClient, gnoClient, frontendURL))
 
 	// Start transaction processing
 	go func() {
@@ -33,15 +33,25 @@ type Proposal struct {
 	Body      string    `firestore:"body" json:"body"`             // Detailed description and content of the proposal
 	Proposer  string    `firestore:"proposer" json:"proposer"`     // Address of the user who created the proposal
 	Deadline  time.Time `firestore:"deadline" json:"deadline"`     // Unix timestamp when voting period ends
-	Status    string    `firestore:"status" json:"status"`         // Current status: "active", "passed", "failed"
+	Status    string    `firestore:"status" json:"status"`         // Current status: "active", "rejected", "queued", "executed", "cancelled"
 	CreatedAt time.Time `firestore:"created_at" json:"created_at"` // Timestamp when proposal was created in database
 	LastVote  time.Time `firestore:"last_vote" json:"last_vote"`   // Timestamp of the last vote cast on this proposal
 	Quorum    int64     `firestore:"quorum" json:"quorum"`         // Quorum for the proposal
+	ETA       time.Time `firestore:"eta,omitempty" json:"eta"`     // Time from which a queued proposal can be executed
+	Type      string    `firestore:"type" json:"type"`             // Proposal type its voting rules come from: "standard", "parameter", "upgrade"
+
+	// Cancellation, set once the proposer, the guardian or the DAO cancelled the proposal
+	CancelledBy string    `firestore:"cancelled_by,omitempty" json:"cancelled_by,omitempty"` // Address that cancelled the proposal
+	CancelledAt time.Time `firestore:"cancelled_at,omitempty" json:"cancelled_at,omitempty"` // When the proposal was cancelled
+
+	// Typed actions run once the proposal is executed, each with its "kind" and parameters
+	Actions []map[string]interface{} `firestore:"actions,omitempty" json:"actions"`
 
 	// Transactional aggregates (xVLS power sums)
 	YesVotes     int64 `firestore:"yes_votes" json:"yes_votes"`
 	NoVotes      int64 `firestore:"no_votes" json:"no_votes"`
 	AbstainVotes int64 `firestore:"abstain_votes" json:"abstain_votes"`
+	VetoVotes    int64 `firestore:"veto_votes" json:"veto_votes"`
 	TotalVotes   int64 `firestore:"total_votes" json:"total_votes"`
 }
 
@@ -51,12 +61,24 @@ type Proposal struct {
 type Vote struct {
 	ProposalID string    `firestore:"proposal_id" json:"proposal_id"` // ID of the proposal this vote was cast on
 	Voter      string    `firestore:"voter" json:"voter"`             // Address of the user who cast the vote
-	VoteChoice string    `firestore:"vote_choice" json:"vote_choice"` // Vote choice: "YES", "NO", or "ABSTAIN"
+	VoteChoice string    `firestore:"vote_choice" json:"vote_choice"` // Vote choice: "YES", "NO", "ABSTAIN" or "VETO"
 	Reason     string    `firestore:"reason" json:"reason"`           // Optional reason provided by the voter
 	XVLSAmount int64     `firestore:"xvls_amount" json:"xvls_amount"` // Voting power (xVLS balance) at time of voting
 	Timestamp  time.Time `firestore:"timestamp" json:"timestamp"`     // When the vote was cast
 }
 
+// VoteOverride represents a vote a staker cast with the xVLS it delegated, stored in the vote_overrides subcollection.
+// The overridden power is taken out of the delegatee's vote, whose xvls_amount is kept up to date.
+type VoteOverride struct {
+	ProposalID string    `firestore:"proposal_id" json:"proposal_id"` // ID of the proposal this vote was cast on
+	Staker     string    `firestore:"staker" json:"staker"`           // Address of the staker who overrode its delegatee
+	Delegatee  string    `firestore:"delegatee" json:"delegatee"`     // Address of the delegatee whose vote was overridden
+	VoteChoice string    `firestore:"vote_choice" json:"vote_choice"` // Vote choice: "YES", "NO", "ABSTAIN" or "VETO"
+	Reason     string    `firestore:"reason" json:"reason"`           // Optional reason provided by the staker
+	XVLSAmount int64     `firestore:"xvls_amount" json:"xvls_amount"` // Amount delegated to the delegatee at the proposal snapshot
+	Timestamp  time.Time `firestore:"timestamp" json:"timestamp"`     // When the override was cast
+}
+
 // PendingUnstake represents a pending unstaking operation stored in a user's subcollection.
 // This struct tracks unstaking operations that are in the cooldown period before tokens can be withdrawn.
 type PendingUnstake struct {
@@ -73,6 +95,8 @@ type User struct {
 	DAOMember bool             `firestore:"dao_member" json:"dao_member"` // Whether the user is a member of the DAO
 	StakedVLS map[string]int64 `firestore:"staked_vls" json:"staked_vls"` // Map of delegatee addresses to staked VLS amounts
 	CreatedAt time.Time        `firestore:"created_at" json:"created_at"` // Timestamp when the user document was first created
+
+	PendingRewards map[string]int64 `firestore:"-" json:"pending_rewards,omitempty"` // Claimable staking rewards by reward token, queried from the staker
 }
 
 // Market represents the complete structure of a market document stored in Firestore.
@@ -100,7 +124,22 @@ type Market struct {
 	UpdatedAt               time.Time `firestore:"updated_at" json:"updated_at"`                               // Last time market data was updated
 	LLTV                    string    `firestore:"lltv" json:"lltv"`                                           // Liquidation Loan-to-Value ratio (WAD-scaled, e.g., 75% = 0.75 * 1e18)
 	Fee                     string    `firestore:"fee" json:"fee"`                                             // Market fee (u256 string)
+	SupplyCap               string    `firestore:"supply_cap" json:"supply_cap"`                               // Maximum total supply (u256 string, "0" = no cap)
+	BorrowCap               string    `firestore:"borrow_cap" json:"borrow_cap"`                               // Maximum total borrow (u256 string, "0" = no cap)
 	PoolPath                string    `firestore:"pool_path" json:"pool_path"`                                 // Gnoswap pool id (e.g. "token0:token1:3000")
+	IsToken0Loan            bool      `firestore:"is_token0_loan" json:"is_token0_loan"`                       // Whether token0 of the pool is the loan token
+	IRM                     string    `firestore:"irm" json:"irm"`                                             // Interest rate model name
+	Oracle                  string    `firestore:"oracle" json:"oracle"`                                       // Oracle name (empty = the Gnoswap pool is the oracle)
+	RoutePools              []string  `firestore:"route_pools" json:"route_pools"`                             // Pool paths of the route oracle of a routed market (empty otherwise)
+	CircuitBreakerTripped   bool      `firestore:"circuit_breaker_tripped" json:"circuit_breaker_tripped"`     // Whether the oracle circuit breaker is tripped
+	CircuitBreakerReason    string    `firestore:"circuit_breaker_reason" json:"circuit_breaker_reason"`       // Guard that tripped the circuit breaker ("deviation", "low_liquidity", "price_unavailable")
+	CircuitBreakerUpdatedAt time.Time `firestore:"breaker_updated_at" json:"breaker_updated_at"`               // Last time the circuit breaker state changed
+	SupplyPaused            bool      `firestore:"supply_paused" json:"supply_paused"`                         // Whether supplying is paused
+	BorrowPaused            bool      `firestore:"borrow_paused" json:"borrow_paused"`                         // Whether borrowing is paused
+	LiquidationPaused       bool      `firestore:"liquidation_paused" json:"liquidation_paused"`               // Whether liquidations are paused
+	Frozen                  bool      `firestore:"frozen" json:"frozen"`                                       // Whether every operation on the market is halted
+	TotalBadDebt            string    `firestore:"total_bad_debt" json:"total_bad_debt"`                       // Cumulative bad debt written off from suppliers (u256 string)
+	TotalFeesClaimed        string    `firestore:"total_fees_claimed" json:"total_fees_claimed"`               // Cumulative protocol fees claimed by the treasury (u256 string)
 }
 
 // APRHistory represents a single APR history entry stored in the apr subcollection.
@@ -128,6 +167,36 @@ type MarketHistory struct {
 	BlockHeight float64   `firestore:"block_height" json:"block_height"` // Block height of the transaction
 }
 
+// BadDebtHistory represents a single bad debt entry stored in the bad_debt subcollection.
+// This struct contains the debt written off from suppliers when a position was left without collateral.
+type BadDebtHistory struct {
+	Timestamp      time.Time `firestore:"timestamp" json:"timestamp"`             // When the bad debt was realized
+	Borrower       string    `firestore:"borrower" json:"borrower"`               // Address of the borrower whose debt was written off
+	BadDebtAssets  string    `firestore:"bad_debt_assets" json:"bad_debt_assets"` // Debt written off (u256 string)
+	BadDebtShares  string    `firestore:"bad_debt_shares" json:"bad_debt_shares"` // Borrow shares burned (u256 string)
+	ReserveCovered string    `firestore:"reserve_covered" json:"reserve_covered"` // Part of the debt absorbed by the market reserve (u256 string)
+	TotalBadDebt   string    `firestore:"total_bad_debt" json:"total_bad_debt"`   // Cumulative bad debt of the market after this entry (u256 string)
+	Caller         string    `firestore:"caller" json:"caller"`                   // Address of the liquidator or keeper
+	TxHash         string    `firestore:"tx_hash" json:"tx_hash"`                 // Transaction hash that realized the bad debt
+	EventType      string    `firestore:"event_type" json:"event_type"`           // Type of event: "Liquidate" or "RealizeBadDebt"
+	Index          float64   `firestore:"index" json:"index"`                     // Index of the transaction in the block
+	BlockHeight    float64   `firestore:"block_height" json:"block_height"`       // Block height of the transaction
+}
+
+// FeeClaim represents a single protocol fee claim stored in the fee_claims subcollection.
+// Protocol fees are accounted apart from supplier positions, this tracks the treasury revenue of a market.
+type FeeClaim struct {
+	Timestamp        time.Time `firestore:"timestamp" json:"timestamp"`                   // When the fees were claimed
+	Amount           string    `firestore:"amount" json:"amount"`                         // Claimed amount in loan token (u256 string)
+	Receiver         string    `firestore:"receiver" json:"receiver"`                     // Address that received the fees
+	TotalFeesClaimed string    `firestore:"total_fees_claimed" json:"total_fees_claimed"` // Cumulative fees claimed from the market after this entry (u256 string)
+	LoanPrice        float64   `firestore:"loan_price" json:"loan_price"`                 // Price of the loan token at the time of the claim
+	Caller           string    `firestore:"caller" json:"caller"`                         // Address that triggered the claim
+	TxHash           string    `firestore:"tx_hash" json:"tx_hash"`                       // Transaction hash of the claim
+	Index            float64   `firestore:"index" json:"index"`                           // Index of the transaction in the block
+	BlockHeight      float64   `firestore:"block_height" json:"block_height"`             // Block height of the transaction
+}
+
 // UtilizationHistory represents a single utilization history entry stored in the utilization subcollection.
 // This struct contains the utilization rate at a specific point in time.
 type UtilizationHistory struct {
@@ -194,6 +194,56 @@ func GetMarketUtilizationHistoryHandler(client *firestore.Client) http.HandlerFu
 	}
 }
 
+// GetMarketBadDebtHistoryHandler handles GET /market/bad-debt-history?marketId=ID&startTime=X&endTime=Y - returns bad debt history for a specific market
+func GetMarketBadDebtHistoryHandler(client *firestore.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		marketID := r.URL.Query().Get("marketId")
+		if marketID == "" {
+			http.Error(w, "marketId query parameter is required", http.StatusBadRequest)
+			return
+		}
+
+		startTimeStr := r.URL.Query().Get("startTime")
+		endTimeStr := r.URL.Query().Get("endTime")
+
+		badDebtHistory, err := dbfetcher.GetMarketBadDebtHistory(client, marketID, startTimeStr, endTimeStr)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(badDebtHistory)
+	}
+}
+
+// GetMarketFeeClaimsHandler handles GET /market/fee-claims?marketId=ID&startTime=X&endTime=Y - returns protocol fee claims for a specific market
+func GetMarketFeeClaimsHandler(client *firestore.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		marketID := r.URL.Query().Get("marketId")
+		if marketID == "" {
+			http.Error(w, "marketId query parameter is required", http.StatusBadRequest)
+			return
+		}
+
+		startTimeStr := r.URL.Query().Get("startTime")
+		endTimeStr := r.URL.Query().Get("endTime")
+
+		feeClaims, err := dbfetcher.GetMarketFeeClaims(client, marketID, startTimeStr, endTimeStr)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(feeClaims)
+	}
+}
+
 // GetMarketSnapshotsHandler handles GET /market/snapshots?marketId=ID&resolution=4hour&startTime=X&endTime=Y
 func GetMarketSnapshotsHandler(client *firestore.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
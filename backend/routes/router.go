@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"cloud.google.com/go/firestore"
+	"github.com/gnolang/gno/gno.land/pkg/gnoclient"
 )
 
 // withCORS adds CORS headers to HTTP responses
@@ -22,7 +23,7 @@ func withCORS(handler http.HandlerFunc, frontendURL string) http.HandlerFunc {
 }
 
 // APIRouter handles all API routes with path-based routing
-func APIRouter(client *firestore.Client, frontendURL string) http.HandlerFunc {
+func APIRouter(client *firestore.Client, gnoClient *gnoclient.Client, frontendURL string) http.HandlerFunc {
 	return withCORS(func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 
@@ -30,7 +31,7 @@ func APIRouter(client *firestore.Client, frontendURL string) http.HandlerFunc {
 		case "/api/market-activity":
 			GetMarketActivityHandler(client)(w, r)
 		case "/api/user":
-			GetUserHandler(client)(w, r)
+			GetUserHandler(client, gnoClient)(w, r)
 		case "/api/user-vote":
 			GetUserVoteHandler(client)(w, r)
 		case "/api/user-pending-unstakes":
@@ -49,6 +50,10 @@ func APIRouter(client *firestore.Client, frontendURL string) http.HandlerFunc {
 			GetMarketTotalSupplyHistoryHandler(client)(w, r)
 		case "/api/collateral-supply-history":
 			GetMarketTotalCollateralSupplyHistoryHandler(client)(w, r)
+		case "/api/bad-debt-history":
+			GetMarketBadDebtHistoryHandler(client)(w, r)
+		case "/api/fee-claims":
+			GetMarketFeeClaimsHandler(client)(w, r)
 		case "/api/utilization-history":
 			GetMarketUtilizationHistoryHandler(client)(w, r)
 		case "/api/snapshots":
@@ -2,14 +2,16 @@ package routes
 
 import (
 	"encoding/json"
+	"log/slog"
 	"net/http"
 	"volos-backend/services/dbfetcher"
 
 	"cloud.google.com/go/firestore"
+	"github.com/gnolang/gno/gno.land/pkg/gnoclient"
 )
 
 // GetUserHandler handles GET /user?address=ADDRESS - returns user data
-func GetUserHandler(client *firestore.Client) http.HandlerFunc {
+func GetUserHandler(client *firestore.Client, gnoClient *gnoclient.Client) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 
@@ -25,6 +27,13 @@ func GetUserHandler(client *firestore.Client) http.HandlerFunc {
 			return
 		}
 
+		pendingRewards, err := dbfetcher.GetUserPendingRewards(gnoClient, userAddress)
+		if err != nil {
+			slog.Error("failed to query pending rewards", "user", userAddress, "error", err)
+		} else {
+			user.PendingRewards = pendingRewards
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(user)
 	}
@@ -142,6 +142,16 @@ func GetMarketTotalCollateralSupplyHistory(client *firestore.Client, marketID, s
 	return getMarketHistoryInRange[model.MarketHistory](client, marketID, "market_history", []string{"SupplyCollateral", "WithdrawCollateral"}, startTimeStr, endTimeStr, "market total collateral supply history")
 }
 
+// GetMarketBadDebtHistory retrieves bad debt history data for a specific market
+func GetMarketBadDebtHistory(client *firestore.Client, marketID, startTimeStr, endTimeStr string) ([]model.BadDebtHistory, error) {
+	return getMarketHistoryInRange[model.BadDebtHistory](client, marketID, "bad_debt", []string{}, startTimeStr, endTimeStr, "market bad debt history")
+}
+
+// GetMarketFeeClaims retrieves protocol fee claims for a specific market
+func GetMarketFeeClaims(client *firestore.Client, marketID, startTimeStr, endTimeStr string) ([]model.FeeClaim, error) {
+	return getMarketHistoryInRange[model.FeeClaim](client, marketID, "fee_claims", []string{}, startTimeStr, endTimeStr, "market fee claims")
+}
+
 // GetMarketUtilizationHistory retrieves utilization history data for a specific market
 func GetMarketUtilizationHistory(client *firestore.Client, marketID, startTimeStr, endTimeStr string) ([]model.UtilizationHistory, error) {
 	return getMarketHistoryInRange[model.UtilizationHistory](client, marketID, "utilization", []string{}, startTimeStr, endTimeStr, "market utilization history")
@@ -51,15 +51,16 @@ func GetProposals(client *firestore.Client, limit int, lastDocID string) (*Propo
 			continue
 		}
 
-		yesVotes, noVotes, abstainVotes, totalVotes, err := calculateVoteTotals(client, proposal.ID)
+		yesVotes, noVotes, abstainVotes, vetoVotes, totalVotes, err := calculateVoteTotals(client, proposal.ID)
 		if err != nil {
 			slog.Error("Error calculating vote totals for proposal", "proposal_id", proposal.ID, "error", err)
-			yesVotes, noVotes, abstainVotes, totalVotes = 0, 0, 0, 0
+			yesVotes, noVotes, abstainVotes, vetoVotes, totalVotes = 0, 0, 0, 0, 0
 		}
 
 		proposal.YesVotes = yesVotes
 		proposal.NoVotes = noVotes
 		proposal.AbstainVotes = abstainVotes
+		proposal.VetoVotes = vetoVotes
 		proposal.TotalVotes = totalVotes
 
 		proposals = append(proposals, proposal)
@@ -112,15 +113,16 @@ func GetActiveProposals(client *firestore.Client, limit int, lastDocID string) (
 			continue
 		}
 
-		yesVotes, noVotes, abstainVotes, totalVotes, err := calculateVoteTotals(client, proposal.ID)
+		yesVotes, noVotes, abstainVotes, vetoVotes, totalVotes, err := calculateVoteTotals(client, proposal.ID)
 		if err != nil {
 			slog.Error("Error calculating vote totals for proposal", "proposal_id", proposal.ID, "error", err)
-			yesVotes, noVotes, abstainVotes, totalVotes = 0, 0, 0, 0
+			yesVotes, noVotes, abstainVotes, vetoVotes, totalVotes = 0, 0, 0, 0, 0
 		}
 
 		proposal.YesVotes = yesVotes
 		proposal.NoVotes = noVotes
 		proposal.AbstainVotes = abstainVotes
+		proposal.VetoVotes = vetoVotes
 		proposal.TotalVotes = totalVotes
 
 		proposals = append(proposals, proposal)
@@ -155,15 +157,16 @@ func GetProposal(client *firestore.Client, proposalID string) (*model.Proposal,
 		return nil, err
 	}
 
-	yesVotes, noVotes, abstainVotes, totalVotes, err := calculateVoteTotals(client, proposal.ID)
+	yesVotes, noVotes, abstainVotes, vetoVotes, totalVotes, err := calculateVoteTotals(client, proposal.ID)
 	if err != nil {
 		slog.Error("Error calculating vote totals for proposal", "proposal_id", proposal.ID, "error", err)
-		yesVotes, noVotes, abstainVotes, totalVotes = 0, 0, 0, 0
+		yesVotes, noVotes, abstainVotes, vetoVotes, totalVotes = 0, 0, 0, 0, 0
 	}
 
 	proposal.YesVotes = yesVotes
 	proposal.NoVotes = noVotes
 	proposal.AbstainVotes = abstainVotes
+	proposal.VetoVotes = vetoVotes
 	proposal.TotalVotes = totalVotes
 
 	return &proposal, nil
@@ -219,14 +222,14 @@ func GetUserVoteOnProposal(client *firestore.Client, proposalID, userAddress str
 // This approach fetches only the necessary fields instead of full documents
 //
 // todo: when firebase supports aggregation queries for go sdk, use those intead
-func calculateVoteTotals(client *firestore.Client, proposalID string) (int64, int64, int64, int64, error) {
+func calculateVoteTotals(client *firestore.Client, proposalID string) (int64, int64, int64, int64, int64, error) {
 	ctx := context.Background()
 	votesRef := client.Collection("proposals").Doc(proposalID).Collection("votes")
 
 	yesQuery := votesRef.Where("vote_choice", "==", "YES").Select("xvls_amount")
 	yesDocs, err := yesQuery.Documents(ctx).GetAll()
 	if err != nil {
-		return 0, 0, 0, 0, err
+		return 0, 0, 0, 0, 0, err
 	}
 
 	var yesVotes int64
@@ -239,7 +242,7 @@ func calculateVoteTotals(client *firestore.Client, proposalID string) (int64, in
 	noQuery := votesRef.Where("vote_choice", "==", "NO").Select("xvls_amount")
 	noDocs, err := noQuery.Documents(ctx).GetAll()
 	if err != nil {
-		return 0, 0, 0, 0, err
+		return 0, 0, 0, 0, 0, err
 	}
 
 	var noVotes int64
@@ -252,7 +255,7 @@ func calculateVoteTotals(client *firestore.Client, proposalID string) (int64, in
 	abstainQuery := votesRef.Where("vote_choice", "==", "ABSTAIN").Select("xvls_amount")
 	abstainDocs, err := abstainQuery.Documents(ctx).GetAll()
 	if err != nil {
-		return 0, 0, 0, 0, err
+		return 0, 0, 0, 0, 0, err
 	}
 
 	var abstainVotes int64
@@ -262,6 +265,19 @@ func calculateVoteTotals(client *firestore.Client, proposalID string) (int64, in
 		}
 	}
 
-	totalVotes := yesVotes + noVotes + abstainVotes
-	return yesVotes, noVotes, abstainVotes, totalVotes, nil
+	vetoQuery := votesRef.Where("vote_choice", "==", "VETO").Select("xvls_amount")
+	vetoDocs, err := vetoQuery.Documents(ctx).GetAll()
+	if err != nil {
+		return 0, 0, 0, 0, 0, err
+	}
+
+	var vetoVotes int64
+	for _, doc := range vetoDocs {
+		if amount, ok := doc.Data()["xvls_amount"].(int64); ok {
+			vetoVotes += amount
+		}
+	}
+
+	totalVotes := yesVotes + noVotes + abstainVotes + vetoVotes
+	return yesVotes, noVotes, abstainVotes, vetoVotes, totalVotes, nil
 }
@@ -2,14 +2,18 @@ package dbfetcher
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"volos-backend/model"
 	"volos-backend/services/utils"
 
 	"cloud.google.com/go/firestore"
+	"github.com/gnolang/gno/gno.land/pkg/gnoclient"
 )
 
 // GetUser retrieves user data from Firestore by user address
@@ -29,6 +33,39 @@ func GetUser(client *firestore.Client, userAddress string) (*model.User, error)
 	return &user, nil
 }
 
+// GetUserPendingRewards queries the staker contract for the staking rewards a user can claim, keyed by reward token
+func GetUserPendingRewards(gnoClient *gnoclient.Client, userAddress string) (map[string]int64, error) {
+	res, _, err := gnoClient.QEval(model.StakerPkgPath, "ApiGetPendingRewards(\""+userAddress+"\")")
+	if err != nil {
+		return nil, err
+	}
+
+	raw := utils.ParseABCIstring(res, "pending rewards")
+	if raw == "" {
+		return nil, fmt.Errorf("invalid pending rewards response")
+	}
+	unquoted, err := strconv.Unquote("\"" + raw + "\"")
+	if err != nil {
+		return nil, err
+	}
+
+	var amounts map[string]string
+	if err := json.Unmarshal([]byte(unquoted), &amounts); err != nil {
+		return nil, err
+	}
+
+	rewards := make(map[string]int64, len(amounts))
+	for token, amount := range amounts {
+		value, err := strconv.ParseInt(amount, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		rewards[token] = value
+	}
+
+	return rewards, nil
+}
+
 // GetUserPendingUnstakes retrieves all pending unstake documents from a user's pendingUnstakes subcollection
 func GetUserPendingUnstakes(client *firestore.Client, userAddress string) ([]model.PendingUnstake, error) {
 	ctx := context.Background()
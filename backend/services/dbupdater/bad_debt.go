@@ -0,0 +1,93 @@
+package dbupdater
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+	"volos-backend/services/utils"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecordBadDebt socializes bad debt written off by a liquidation or a RealizeBadDebt call.
+// It removes the debt from the market's total_borrow and total_borrow_shares, and the part the reserve
+// did not cover from total_supply.
+// The debt is added to total_bad_debt and an entry is appended to the bad_debt subcollection.
+// Liquidations without bad debt (empty or zero badDebtAssets) are ignored.
+func RecordBadDebt(client *firestore.Client, marketID, borrower, badDebtAssets, badDebtShares, reserveCovered, timestamp string, caller string, txHash string, eventType string, index float64, blockHeight float64) {
+	if badDebtAssets == "" || badDebtAssets == "0" {
+		return
+	}
+
+	sanitizedMarketID := strings.ReplaceAll(marketID, "/", "_")
+	ctx := context.Background()
+
+	sec := utils.ParseTimestamp(timestamp, "bad debt record")
+	if sec == 0 {
+		return
+	}
+	eventTime := time.Unix(sec, 0)
+
+	assets := utils.ParseAmount(badDebtAssets, "bad debt record")
+	if assets.Sign() == 0 {
+		return
+	}
+	shares := utils.ParseAmount(badDebtShares, "bad debt record")
+
+	// Older events carry no reserve data, the whole loss then fell on suppliers
+	covered := big.NewInt(0)
+	if reserveCovered != "" {
+		covered = utils.ParseAmount(reserveCovered, "bad debt record")
+	}
+	supplierLoss := new(big.Int).Sub(assets, covered)
+
+	marketRef := client.Collection("markets").Doc(sanitizedMarketID)
+
+	var totalBadDebtStr string
+	if err := client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		dsnap, err := tx.Get(marketRef)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return err
+			}
+		}
+
+		totalBadDebtStr = UpdateAmountInDoc(GetAmountFromDoc(dsnap, "total_bad_debt"), assets, true)
+
+		updates := map[string]interface{}{
+			"total_bad_debt":      totalBadDebtStr,
+			"total_supply":        UpdateAmountInDoc(GetAmountFromDoc(dsnap, "total_supply"), supplierLoss, false),
+			"total_borrow":        UpdateAmountInDoc(GetAmountFromDoc(dsnap, "total_borrow"), assets, false),
+			"total_borrow_shares": UpdateAmountInDoc(GetAmountFromDoc(dsnap, "total_borrow_shares"), shares, false),
+		}
+		return tx.Set(marketRef, updates, firestore.MergeAll)
+	}); err != nil {
+		slog.Error("failed to record bad debt in database", "market_id", marketID, "bad_debt_assets", badDebtAssets, "event_type", eventType, "error", err)
+		return
+	}
+
+	history := map[string]interface{}{
+		"timestamp":       eventTime,
+		"borrower":        borrower,
+		"bad_debt_assets": badDebtAssets,
+		"bad_debt_shares": badDebtShares,
+		"reserve_covered": covered.String(),
+		"total_bad_debt":  totalBadDebtStr,
+		"caller":          caller,
+		"tx_hash":         txHash,
+		"event_type":      eventType,
+		"index":           index,
+		"block_height":    blockHeight,
+	}
+
+	if _, err := marketRef.Collection("bad_debt").NewDoc().Set(ctx, history); err != nil {
+		slog.Error("failed to add bad debt history entry", "market_id", marketID, "error", err)
+		return
+	}
+
+	slog.Info("bad debt recorded", "market_id", marketID, "borrower", borrower, "bad_debt_assets", badDebtAssets, "total_bad_debt", totalBadDebtStr)
+}
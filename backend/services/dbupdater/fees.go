@@ -0,0 +1,72 @@
+package dbupdater
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+	"volos-backend/services"
+	"volos-backend/services/utils"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecordFeeClaim adds claimed protocol fees to the market's total_fees_claimed and appends an entry
+// to the fee_claims subcollection, so treasury revenue is reported apart from supplier positions.
+func RecordFeeClaim(client *firestore.Client, marketID, receiver, amount, timestamp string, caller string, txHash string, index float64, blockHeight float64) {
+	sanitizedMarketID := strings.ReplaceAll(marketID, "/", "_")
+	ctx := context.Background()
+
+	sec := utils.ParseTimestamp(timestamp, "fee claim record")
+	if sec == 0 {
+		return
+	}
+	eventTime := time.Unix(sec, 0)
+
+	amt := utils.ParseAmount(amount, "fee claim record")
+	if amt.Sign() == 0 {
+		return
+	}
+
+	marketRef := client.Collection("markets").Doc(sanitizedMarketID)
+
+	var totalClaimedStr string
+	if err := client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		dsnap, err := tx.Get(marketRef)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return err
+			}
+		}
+
+		totalClaimedStr = UpdateAmountInDoc(GetAmountFromDoc(dsnap, "total_fees_claimed"), amt, true)
+
+		return tx.Set(marketRef, map[string]interface{}{
+			"total_fees_claimed": totalClaimedStr,
+		}, firestore.MergeAll)
+	}); err != nil {
+		slog.Error("failed to record fee claim in database", "market_id", marketID, "amount", amount, "error", err)
+		return
+	}
+
+	claim := map[string]interface{}{
+		"timestamp":          eventTime,
+		"amount":             amount,
+		"receiver":           receiver,
+		"total_fees_claimed": totalClaimedStr,
+		"loan_price":         services.GetTokenPrice(marketID),
+		"caller":             caller,
+		"tx_hash":            txHash,
+		"index":              index,
+		"block_height":       blockHeight,
+	}
+
+	if _, err := marketRef.Collection("fee_claims").NewDoc().Set(ctx, claim); err != nil {
+		slog.Error("failed to add fee claim entry", "market_id", marketID, "error", err)
+		return
+	}
+
+	slog.Info("fee claim recorded", "market_id", marketID, "receiver", receiver, "amount", amount, "total_fees_claimed", totalClaimedStr)
+}
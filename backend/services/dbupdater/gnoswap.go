@@ -3,73 +3,111 @@ package dbupdater
 import (
 	"context"
 	"log/slog"
-	"strings"
+	"volos-backend/model"
+	"volos-backend/services/utils"
 
 	"cloud.google.com/go/firestore"
+	"github.com/gnolang/gno/gno.land/pkg/gnoclient"
 )
 
-// UpdatePrice updates the current_price field for markets that have a matching poolPath
-// poolPath is extracted from the provided marketID by removing the ":0" or ":1" suffix
-// Uses Firestore transaction to atomically fetch markets, get token decimals, and update prices
-func UpdatePrice(firestoreClient *firestore.Client, sqrtPriceX96, marketID string) {
-	if sqrtPriceX96 == "" || marketID == "" {
-		slog.Error("missing sqrtPriceX96 or marketID for price update", "sqrtPriceX96", sqrtPriceX96, "marketID", marketID)
+// UpdatePrice updates the current_price field for all markets that have a matching poolPath
+// Several markets can share a pool and price it differently (direction, TWAP window, registered oracle),
+// so each market's price is read from the core oracle rather than derived from the pool's spot price
+// The price is left unchanged while core does not return one (TWAP history shorter than the window)
+func UpdatePrice(firestoreClient *firestore.Client, gnoClient *gnoclient.Client, poolPath string) {
+	if poolPath == "" {
+		slog.Error("missing poolPath for price update")
 		return
 	}
 
-	poolPath := strings.TrimSuffix(strings.TrimSuffix(marketID, ":0"), ":1")
-	revert := strings.HasSuffix(marketID, ":1")
-
 	ctx := context.Background()
 	marketsRef := firestoreClient.Collection("markets")
 
 	iter := marketsRef.Where("pool_path", "==", poolPath).Documents(ctx)
 	defer iter.Stop()
 
-	var marketDocs []*firestore.DocumentSnapshot
+	prices := make(map[*firestore.DocumentRef]string)
 	for {
 		doc, err := iter.Next()
 		if err != nil {
 			break
 		}
-		marketDocs = append(marketDocs, doc)
-	}
 
-	err := firestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
-		for _, marketDoc := range marketDocs {
-			loanTokenDecimals, err := marketDoc.DataAt("loan_token_decimals")
-			if err != nil {
-				slog.Error("failed to get loan_token_decimals", "market_id", marketDoc.Ref.ID, "error", err)
-				continue
-			}
+		marketID, err := doc.DataAt("id")
+		if err != nil {
+			slog.Error("failed to get market id", "market_id", doc.Ref.ID, "error", err)
+			continue
+		}
 
-			collateralTokenDecimals, err := marketDoc.DataAt("collateral_token_decimals")
-			if err != nil {
-				slog.Error("failed to get collateral_token_decimals", "market_id", marketDoc.Ref.ID, "error", err)
-				continue
-			}
+		res, _, err := gnoClient.QEval(model.CorePkgPath, "GetMarketPrice(\""+marketID.(string)+"\")")
+		if err != nil {
+			slog.Error("failed to query market price from blockchain", "market_id", doc.Ref.ID, "error", err)
+			continue
+		}
 
-			price := extractPriceFromSqrt(sqrtPriceX96, revert, loanTokenDecimals.(int64), collateralTokenDecimals.(int64))
-			if price == "" {
-				slog.Error("failed to extract price", "sqrtPriceX96", sqrtPriceX96, "market_id", marketDoc.Ref.ID)
-				continue
-			}
+		if price := utils.ParseABCIstring(res, "price update"); price != "" {
+			prices[doc.Ref] = price
+		}
+	}
 
-			if err := tx.Update(marketDoc.Ref, []firestore.Update{{Path: "current_price", Value: price}}); err != nil {
-				slog.Error("failed to update market price", "market_id", marketDoc.Ref.ID, "error", err)
+	err := firestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		for ref, price := range prices {
+			if err := tx.Update(ref, []firestore.Update{{Path: "current_price", Value: price}}); err != nil {
+				slog.Error("failed to update market price", "market_id", ref.ID, "error", err)
 				return err
 			}
 
-			slog.Info("market price updated in transaction", "market_id", marketDoc.Ref.ID, "poolPath", poolPath, "price", price)
+			slog.Info("market price updated in transaction", "market_id", ref.ID, "poolPath", poolPath, "price", price)
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		slog.Error("transaction failed for price update", "poolPath", poolPath, "marketID", marketID, "error", err)
+		slog.Error("transaction failed for price update", "poolPath", poolPath, "error", err)
 		return
 	}
 
-	slog.Info("price updated", "market_id", marketID, "poolPath", poolPath)
+	slog.Info("price updated", "poolPath", poolPath, "markets", len(prices))
+}
+
+// UpdateRoutedPrices updates the current_price field of the routed markets whose route goes through poolPath
+// Routed markets have no pool of their own, their price is the TWAP of each pool of their route
+// The price is left unchanged while core does not return one (TWAP history shorter than the window)
+func UpdateRoutedPrices(firestoreClient *firestore.Client, gnoClient *gnoclient.Client, poolPath string) {
+	ctx := context.Background()
+
+	iter := firestoreClient.Collection("markets").Where("route_pools", "array-contains", poolPath).Documents(ctx)
+	defer iter.Stop()
+
+	for {
+		doc, err := iter.Next()
+		if err != nil {
+			break
+		}
+
+		marketID, err := doc.DataAt("id")
+		if err != nil {
+			slog.Error("failed to get market id", "market_id", doc.Ref.ID, "error", err)
+			continue
+		}
+
+		res, _, err := gnoClient.QEval(model.CorePkgPath, "GetMarketPrice(\""+marketID.(string)+"\")")
+		if err != nil {
+			slog.Error("failed to query routed market price from blockchain", "market_id", doc.Ref.ID, "error", err)
+			continue
+		}
+
+		price := utils.ParseABCIstring(res, "routed price update")
+		if price == "" {
+			continue
+		}
+
+		if _, err := doc.Ref.Update(ctx, []firestore.Update{{Path: "current_price", Value: price}}); err != nil {
+			slog.Error("failed to update routed market price", "market_id", doc.Ref.ID, "error", err)
+			continue
+		}
+
+		slog.Info("routed market price updated", "market_id", doc.Ref.ID, "price", price)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"strings"
 	"time"
+	"volos-backend/model"
 	"volos-backend/services/utils"
 
 	"cloud.google.com/go/firestore"
@@ -13,9 +14,15 @@ import (
 
 // CreateMarket creates a new market in the Firestore database.
 // It uses sanitizedMarketID (replacing "/" with "_") to avoid issues with Firestore document IDs.
+// Routed markets have an empty poolPath, their initial price is read from the core oracle instead of a Gnoswap pool,
+// and the pools of their route are stored in route_pools.
+// Several markets can share a pool, they differ by direction, IRM, LLTV or oracle.
 func CreateMarket(client *firestore.Client,
 	gnoClient *gnoclient.Client,
-	marketID, loanToken, collateralToken string,
+	marketID, poolPath string,
+	isToken0Loan bool,
+	irm, oracle string,
+	loanToken, collateralToken string,
 	loanTokenName string,
 	loanTokenSymbol string,
 	loanTokenDecimals string,
@@ -27,24 +34,41 @@ func CreateMarket(client *firestore.Client,
 ) {
 
 	sanitizedMarketID := strings.ReplaceAll(marketID, "/", "_")
-	poolPath := strings.ReplaceAll(marketID, ":0", "")
-
-	res, _, err := gnoClient.QEval("gno.land/r/gnoswap/v1/pool", "PoolGetSlot0SqrtPriceX96(\""+poolPath+"\")")
-	if err != nil {
-		slog.Error("failed to query pool price from blockchain", "poolPath", poolPath, "error", err)
-		return
-	}
-
-	sqrtPriceX96 := utils.ParseABCIstring(res, "market creation")
 	loanDecimals := utils.ParseInt64(loanTokenDecimals, "market creation loanTokenDecimals")
 	collDecimals := utils.ParseInt64(collateralTokenDecimals, "market creation collateralTokenDecimals")
 
 	var currentPrice string
-	if sqrtPriceX96 != "" {
-		revert := strings.HasSuffix(marketID, ":1")
-		currentPrice = extractPriceFromSqrt(sqrtPriceX96, revert, loanDecimals, collDecimals)
-		if currentPrice == "" {
-			slog.Error("failed to extract price from sqrtPriceX96", "sqrtPriceX96", sqrtPriceX96, "marketID", marketID)
+	var routePools []string
+	if poolPath == "" {
+		res, _, err := gnoClient.QEval(model.CorePkgPath, "GetMarketPrice(\""+marketID+"\")")
+		if err != nil {
+			slog.Error("failed to query oracle price from blockchain", "marketID", marketID, "error", err)
+			return
+		}
+		currentPrice = utils.ParseABCIstring(res, "market creation")
+
+		// Index the pools of the route, so only swaps on them refresh the market's price
+		res, _, err = gnoClient.QEval(model.CorePkgPath, "GetOracleRoute(\""+oracle+"\")")
+		if err != nil {
+			slog.Error("failed to query oracle route from blockchain", "marketID", marketID, "oracle", oracle, "error", err)
+			return
+		}
+		if route := utils.ParseABCIstring(res, "market creation"); route != "" {
+			routePools = strings.Split(route, ",")
+		}
+	} else {
+		res, _, err := gnoClient.QEval("gno.land/r/gnoswap/v1/pool", "PoolGetSlot0SqrtPriceX96(\""+poolPath+"\")")
+		if err != nil {
+			slog.Error("failed to query pool price from blockchain", "poolPath", poolPath, "error", err)
+			return
+		}
+
+		sqrtPriceX96 := utils.ParseABCIstring(res, "market creation")
+		if sqrtPriceX96 != "" {
+			currentPrice = extractPriceFromSqrt(sqrtPriceX96, isToken0Loan, loanDecimals, collDecimals)
+			if currentPrice == "" {
+				slog.Error("failed to extract price from sqrtPriceX96", "sqrtPriceX96", sqrtPriceX96, "marketID", marketID)
+			}
 		}
 	}
 	timestampInt := utils.ParseTimestamp(timestamp, "market creation")
@@ -55,6 +79,9 @@ func CreateMarket(client *firestore.Client,
 	marketData := map[string]interface{}{
 		"id":                        marketID,
 		"pool_path":                 poolPath,
+		"is_token0_loan":            isToken0Loan,
+		"irm":                       irm,
+		"oracle":                    oracle,
 		"loan_token":                loanToken,
 		"collateral_token":          collateralToken,
 		"loan_token_name":           loanTokenName,
@@ -66,13 +93,20 @@ func CreateMarket(client *firestore.Client,
 		"created_at":                time.Unix(timestampInt, 0),
 		"lltv":                      lltv,
 		"fee":                       "0",
+		"supply_cap":                "0",
+		"borrow_cap":                "0",
+		"total_bad_debt":            "0",
+		"total_fees_claimed":        "0",
 	}
 
 	if currentPrice != "" {
 		marketData["current_price"] = currentPrice
 	}
+	if len(routePools) > 0 {
+		marketData["route_pools"] = routePools
+	}
 
-	_, err = client.Collection("markets").Doc(sanitizedMarketID).Set(context.Background(), marketData)
+	_, err := client.Collection("markets").Doc(sanitizedMarketID).Set(context.Background(), marketData)
 	if err != nil {
 		slog.Error("failed to create market in database", "market_id", marketID, "loan_token", loanToken, "collateral_token", collateralToken, "error", err)
 		return
@@ -98,3 +132,85 @@ func UpdateMarketFee(client *firestore.Client, marketID, fee string) {
 
 	slog.Info("market fee updated", "market_id", marketID, "fee", fee)
 }
+
+// UpdateMarketCaps updates the supply_cap and borrow_cap fields of a market ("0" = no cap).
+func UpdateMarketCaps(client *firestore.Client, marketID, supplyCap, borrowCap string) {
+	sanitizedMarketID := strings.ReplaceAll(marketID, "/", "_")
+
+	_, err := client.Collection("markets").Doc(sanitizedMarketID).Update(context.Background(), []firestore.Update{
+		{
+			Path:  "supply_cap",
+			Value: supplyCap,
+		},
+		{
+			Path:  "borrow_cap",
+			Value: borrowCap,
+		},
+	})
+	if err != nil {
+		slog.Error("failed to update market caps in database", "market_id", marketID, "supply_cap", supplyCap, "borrow_cap", borrowCap, "error", err)
+		return
+	}
+
+	slog.Info("market caps updated", "market_id", marketID, "supply_cap", supplyCap, "borrow_cap", borrowCap)
+}
+
+// UpdateMarketCircuitBreaker records the oracle circuit breaker state of a market.
+// reason is the guard that tripped the breaker, empty when it is reset.
+func UpdateMarketCircuitBreaker(client *firestore.Client, marketID string, tripped bool, reason, timestamp string) {
+	sanitizedMarketID := strings.ReplaceAll(marketID, "/", "_")
+
+	timestampInt := utils.ParseTimestamp(timestamp, "circuit breaker update")
+	if timestampInt == 0 {
+		return
+	}
+
+	_, err := client.Collection("markets").Doc(sanitizedMarketID).Update(context.Background(), []firestore.Update{
+		{
+			Path:  "circuit_breaker_tripped",
+			Value: tripped,
+		},
+		{
+			Path:  "circuit_breaker_reason",
+			Value: reason,
+		},
+		{
+			Path:  "breaker_updated_at",
+			Value: time.Unix(timestampInt, 0),
+		},
+	})
+	if err != nil {
+		slog.Error("failed to update market circuit breaker in database", "market_id", marketID, "tripped", tripped, "error", err)
+		return
+	}
+
+	slog.Info("market circuit breaker updated", "market_id", marketID, "tripped", tripped, "reason", reason)
+}
+
+// UpdateMarketStatus records the emergency flags of a market.
+// An empty marketID holds the protocol wide flags, stored in the "protocol/status" document.
+func UpdateMarketStatus(client *firestore.Client, marketID string, supplyPaused, borrowPaused, liquidationPaused, frozen bool, timestamp string) {
+	timestampInt := utils.ParseTimestamp(timestamp, "market status update")
+	if timestampInt == 0 {
+		return
+	}
+
+	docRef := client.Collection("protocol").Doc("status")
+	if marketID != "" {
+		docRef = client.Collection("markets").Doc(strings.ReplaceAll(marketID, "/", "_"))
+	}
+
+	_, err := docRef.Set(context.Background(), map[string]interface{}{
+		"supply_paused":      supplyPaused,
+		"borrow_paused":      borrowPaused,
+		"liquidation_paused": liquidationPaused,
+		"frozen":             frozen,
+		"status_updated_at":  time.Unix(timestampInt, 0),
+	}, firestore.MergeAll)
+	if err != nil {
+		slog.Error("failed to update market status in database", "market_id", marketID, "error", err)
+		return
+	}
+
+	slog.Info("market status updated", "market_id", marketID, "supply_paused", supplyPaused, "borrow_paused", borrowPaused, "liquidation_paused", liquidationPaused, "frozen", frozen)
+}
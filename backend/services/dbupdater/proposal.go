@@ -2,6 +2,7 @@ package dbupdater
 
 import (
 	"context"
+	"encoding/json"
 	"log/slog"
 	"time"
 	"volos-backend/model"
@@ -13,7 +14,7 @@ import (
 )
 
 // CreateProposal creates a new proposal in the Firestore database
-func CreateProposal(client *firestore.Client, proposalID, title, body, proposer, deadlineStr, quorumStr, timestampStr string) {
+func CreateProposal(client *firestore.Client, proposalID, title, body, proposer, deadlineStr, quorumStr, proposalType, actionsJSON, timestampStr string) {
 	ctx := context.Background()
 
 	deadlineUnix := utils.ParseTimestamp(deadlineStr, "proposal deadline")
@@ -43,6 +44,13 @@ func CreateProposal(client *firestore.Client, proposalID, title, body, proposer,
 		CreatedAt: createdAt,
 		LastVote:  createdAt,
 		Quorum:    quorum,
+		Type:      proposalType,
+	}
+
+	if actionsJSON != "" {
+		if err := json.Unmarshal([]byte(actionsJSON), &proposal.Actions); err != nil {
+			slog.Error("failed to parse proposal actions", "proposal_id", proposalID, "actions", actionsJSON, "error", err)
+		}
 	}
 
 	_, err := client.Collection("proposals").Doc(proposalID).Set(ctx, proposal)
@@ -106,7 +114,7 @@ func AddVote(client *firestore.Client, proposalID, voter, voteChoice, reason, ti
 			}
 		}
 
-		incYes, incNo, incAbstain, incTotal := int64(0), int64(0), int64(0), int64(0)
+		incYes, incNo, incAbstain, incVeto, incTotal := int64(0), int64(0), int64(0), int64(0), int64(0)
 		switch prevChoice {
 		case "YES":
 			incYes -= prevAmount
@@ -114,6 +122,8 @@ func AddVote(client *firestore.Client, proposalID, voter, voteChoice, reason, ti
 			incNo -= prevAmount
 		case "ABSTAIN":
 			incAbstain -= prevAmount
+		case "VETO":
+			incVeto -= prevAmount
 		}
 		incTotal -= prevAmount
 
@@ -124,6 +134,8 @@ func AddVote(client *firestore.Client, proposalID, voter, voteChoice, reason, ti
 			incNo += xvlsAmount
 		case "ABSTAIN":
 			incAbstain += xvlsAmount
+		case "VETO":
+			incVeto += xvlsAmount
 		}
 		incTotal += xvlsAmount
 
@@ -139,6 +151,9 @@ func AddVote(client *firestore.Client, proposalID, voter, voteChoice, reason, ti
 		if incAbstain != 0 {
 			updates = append(updates, firestore.Update{Path: "abstain_votes", Value: firestore.Increment(incAbstain)})
 		}
+		if incVeto != 0 {
+			updates = append(updates, firestore.Update{Path: "veto_votes", Value: firestore.Increment(incVeto)})
+		}
 		if incTotal != 0 {
 			updates = append(updates, firestore.Update{Path: "total_votes", Value: firestore.Increment(incTotal)})
 		}
@@ -169,3 +184,98 @@ func AddVote(client *firestore.Client, proposalID, voter, voteChoice, reason, ti
 		return
 	}
 }
+
+// AddVoteOverride stores a staker's override vote and moves the overridden power out of the delegatee's vote.
+// Aggregate counters are updated transactionally with the deltas of the previous override and delegatee vote.
+// delegateeVote is empty if the delegatee did not vote, delegateeAmount is its voting power once overridden.
+func AddVoteOverride(client *firestore.Client, proposalID, staker, delegatee, voteChoice, reason, timestampStr string, xvlsAmount int64, delegateeVote string, delegateeAmount int64) {
+	ctx := context.Background()
+
+	voteTimeUnix := utils.ParseTimestamp(timestampStr, "vote override timestamp")
+	if voteTimeUnix == 0 {
+		return
+	}
+	voteTime := time.Unix(voteTimeUnix, 0)
+
+	proposalRef := client.Collection("proposals").Doc(proposalID)
+	overrideDocRef := proposalRef.Collection("vote_overrides").Doc(staker + ":" + delegatee)
+	delegateeVoteRef := proposalRef.Collection("votes").Doc(delegatee)
+
+	if err := client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		deltas := map[string]int64{}
+
+		oSnap, err := tx.Get(overrideDocRef)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return err
+			}
+		} else if oSnap.Exists() {
+			var existing model.VoteOverride
+			if err := oSnap.DataTo(&existing); err == nil {
+				deltas[existing.VoteChoice] -= existing.XVLSAmount
+			}
+		}
+		deltas[voteChoice] += xvlsAmount
+
+		updateDelegateeVote := false
+		if delegateeVote != "" {
+			vSnap, err := tx.Get(delegateeVoteRef)
+			if err != nil {
+				if status.Code(err) != codes.NotFound {
+					return err
+				}
+			} else if vSnap.Exists() {
+				var existing model.Vote
+				if err := vSnap.DataTo(&existing); err == nil {
+					deltas[existing.VoteChoice] -= existing.XVLSAmount
+					deltas[existing.VoteChoice] += delegateeAmount
+					updateDelegateeVote = true
+				}
+			}
+		}
+
+		updates := []firestore.Update{
+			{Path: "last_vote", Value: voteTime},
+		}
+		var incTotal int64
+		for choice, field := range map[string]string{"YES": "yes_votes", "NO": "no_votes", "ABSTAIN": "abstain_votes", "VETO": "veto_votes"} {
+			if deltas[choice] != 0 {
+				updates = append(updates, firestore.Update{Path: field, Value: firestore.Increment(deltas[choice])})
+				incTotal += deltas[choice]
+			}
+		}
+		if incTotal != 0 {
+			updates = append(updates, firestore.Update{Path: "total_votes", Value: firestore.Increment(incTotal)})
+		}
+
+		if err := tx.Update(proposalRef, updates); err != nil {
+			return err
+		}
+
+		if updateDelegateeVote {
+			if err := tx.Update(delegateeVoteRef, []firestore.Update{{Path: "xvls_amount", Value: delegateeAmount}}); err != nil {
+				return err
+			}
+		}
+
+		overrideData := model.VoteOverride{
+			ProposalID: proposalID,
+			Staker:     staker,
+			Delegatee:  delegatee,
+			VoteChoice: voteChoice,
+			Reason:     reason,
+			XVLSAmount: xvlsAmount,
+			Timestamp:  voteTime,
+		}
+		if err := tx.Set(overrideDocRef, overrideData); err != nil {
+			return err
+		}
+
+		slog.Info("successfully added vote override", "proposal_id", proposalID, "staker", staker, "delegatee", delegatee, "vote_choice", voteChoice, "xvls_amount", xvlsAmount)
+
+		return nil
+	}); err != nil {
+		slog.Error("failed to add vote override in database", "proposal_id", proposalID, "staker", staker, "delegatee", delegatee, "error", err)
+		return
+	}
+}
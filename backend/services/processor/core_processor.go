@@ -41,6 +41,10 @@ func processCoreTransaction(tx map[string]interface{}, firestoreClient *firestor
 				dbupdater.CreateMarket(firestoreClient,
 					gnoClient,
 					createEvent.MarketID,
+					createEvent.PoolPath,
+					createEvent.IsToken0Loan == "true",
+					createEvent.IRM,
+					createEvent.Oracle,
 					createEvent.LoanToken,
 					createEvent.CollateralToken,
 					createEvent.LoanTokenName,
@@ -89,8 +93,7 @@ func processCoreTransaction(tx map[string]interface{}, firestoreClient *firestor
 		case "Liquidate":
 			if liquidateEvent, ok := extractLiquidateFields(event); ok {
 				dbupdater.UpdateTotalBorrow(firestoreClient, liquidateEvent.MarketID, liquidateEvent.Amount, liquidateEvent.Shares, liquidateEvent.Timestamp, txMetadata.Caller, txMetadata.Hash, eventType, txMetadata.Index, txMetadata.BlockHeight)
-				// TODO: If borrower collateral becomes zero, also decrease total_supply and any additional bad-debt borrow reduction.
-				//       Requires extra event data (e.g., bad_debt_assets) or a state read/reconciliation pass.
+				dbupdater.RecordBadDebt(firestoreClient, liquidateEvent.MarketID, liquidateEvent.Borrower, liquidateEvent.BadDebtAssets, liquidateEvent.BadDebtShares, liquidateEvent.ReserveCovered, liquidateEvent.Timestamp, txMetadata.Caller, txMetadata.Hash, eventType, txMetadata.Index, txMetadata.BlockHeight)
 				dbupdater.UpdateAPRHistory(firestoreClient, liquidateEvent.MarketID, liquidateEvent.SupplyAPR, liquidateEvent.BorrowAPR, liquidateEvent.Timestamp, txMetadata.Index, txMetadata.BlockHeight)
 				dbupdater.UpdateUtilizationHistory(firestoreClient, liquidateEvent.MarketID, liquidateEvent.Timestamp, liquidateEvent.Utilization, txMetadata.Index, txMetadata.BlockHeight)
 				dbupdater.UpdateUserMarketLoan(firestoreClient, liquidateEvent.Borrower, liquidateEvent.MarketID, liquidateEvent.Amount, liquidateEvent.Shares, eventType)
@@ -118,6 +121,36 @@ func processCoreTransaction(tx map[string]interface{}, firestoreClient *firestor
 				dbupdater.UpdateMarketFee(firestoreClient, setFeeEvent.MarketID, setFeeEvent.Fee)
 			}
 
+		case "CircuitBreakerTripped":
+			if tripEvent, ok := extractCircuitBreakerTrippedFields(event); ok {
+				dbupdater.UpdateMarketCircuitBreaker(firestoreClient, tripEvent.MarketID, true, tripEvent.Reason, tripEvent.Timestamp)
+			}
+
+		case "RealizeBadDebt":
+			if badDebtEvent, ok := extractRealizeBadDebtFields(event); ok {
+				dbupdater.RecordBadDebt(firestoreClient, badDebtEvent.MarketID, badDebtEvent.Borrower, badDebtEvent.BadDebtAssets, badDebtEvent.BadDebtShares, badDebtEvent.ReserveCovered, badDebtEvent.Timestamp, txMetadata.Caller, txMetadata.Hash, eventType, txMetadata.Index, txMetadata.BlockHeight)
+			}
+
+		case "ClaimFees":
+			if claimEvent, ok := extractClaimFeesFields(event); ok {
+				dbupdater.RecordFeeClaim(firestoreClient, claimEvent.MarketID, claimEvent.Receiver, claimEvent.Amount, claimEvent.Timestamp, txMetadata.Caller, txMetadata.Hash, txMetadata.Index, txMetadata.BlockHeight)
+			}
+
+		case "SetCaps":
+			if capsEvent, ok := extractSetCapsFields(event); ok {
+				dbupdater.UpdateMarketCaps(firestoreClient, capsEvent.MarketID, capsEvent.SupplyCap, capsEvent.BorrowCap)
+			}
+
+		case "SetMarketStatus":
+			if statusEvent, ok := extractSetMarketStatusFields(event); ok {
+				dbupdater.UpdateMarketStatus(firestoreClient, statusEvent.MarketID, statusEvent.SupplyPaused, statusEvent.BorrowPaused, statusEvent.LiquidationPaused, statusEvent.Frozen, statusEvent.Timestamp)
+			}
+
+		case "CircuitBreakerReset":
+			if resetEvent, ok := extractCircuitBreakerResetFields(event); ok {
+				dbupdater.UpdateMarketCircuitBreaker(firestoreClient, resetEvent.MarketID, false, "", resetEvent.Timestamp)
+			}
+
 		case "StorageDeposit":
 			continue
 		}
@@ -139,7 +172,8 @@ func extractCreateMarketFields(event map[string]interface{}) (*CreateMarketEvent
 		"lltv",
 	}
 
-	fields, ok := extractEventFields(event, requiredFields, []string{})
+	// poolPath is empty for routed markets
+	fields, ok := extractEventFields(event, requiredFields, []string{"poolPath", "isToken0Loan", "irm", "oracle"})
 	if !ok {
 		slog.Error("failed to extract create market fields", "event", event)
 		return nil, false
@@ -147,8 +181,12 @@ func extractCreateMarketFields(event map[string]interface{}) (*CreateMarketEvent
 
 	return &CreateMarketEvent{
 		MarketID:                fields["market_id"],
+		PoolPath:                fields["poolPath"],
 		LoanToken:               fields["loan_token"],
 		CollateralToken:         fields["collateral_token"],
+		IsToken0Loan:            fields["isToken0Loan"],
+		IRM:                     fields["irm"],
+		Oracle:                  fields["oracle"],
 		LoanTokenName:           fields["loanTokenName"],
 		LoanTokenSymbol:         fields["loanTokenSymbol"],
 		LoanTokenDecimals:       fields["loanTokenDecimals"],
@@ -248,7 +286,8 @@ func extractRepayFields(event map[string]interface{}) (*RepayEvent, bool) {
 
 func extractLiquidateFields(event map[string]interface{}) (*LiquidateEvent, bool) {
 	requiredFields := []string{"market_id", "user", "borrower", "amount", "shares", "seized", "currentTimestamp", "supplyAPR", "borrowAPR", "utilization"}
-	fields, ok := extractEventFields(event, requiredFields, []string{})
+	optionalFields := []string{"badDebtAssets", "badDebtShares", "reserveCovered"}
+	fields, ok := extractEventFields(event, requiredFields, optionalFields)
 	if !ok {
 		slog.Error("failed to extract liquidate fields", "event", event)
 		return nil, false
@@ -265,6 +304,9 @@ func extractLiquidateFields(event map[string]interface{}) (*LiquidateEvent, bool
 		SupplyAPR: fields["supplyAPR"],
 		BorrowAPR: fields["borrowAPR"],
 		Utilization: fields["utilization"],
+		BadDebtAssets: fields["badDebtAssets"],
+		BadDebtShares: fields["badDebtShares"],
+		ReserveCovered: fields["reserveCovered"],
 	}, true
 }
 
@@ -333,3 +375,106 @@ func extractSetFeeFields(event map[string]interface{}) (*SetFeeEvent, bool) {
 		Timestamp: fields["currentTimestamp"],
 	}, true
 }
+
+func extractRealizeBadDebtFields(event map[string]interface{}) (*RealizeBadDebtEvent, bool) {
+	requiredFields := []string{"market_id", "user", "borrower", "seized", "badDebtAssets", "badDebtShares", "currentTimestamp"}
+	fields, ok := extractEventFields(event, requiredFields, []string{"reserveCovered"})
+	if !ok {
+		slog.Error("failed to extract realize bad debt fields", "event", event)
+		return nil, false
+	}
+
+	return &RealizeBadDebtEvent{
+		MarketID:       fields["market_id"],
+		User:           fields["user"],
+		Borrower:       fields["borrower"],
+		Seized:         fields["seized"],
+		BadDebtAssets:  fields["badDebtAssets"],
+		BadDebtShares:  fields["badDebtShares"],
+		ReserveCovered: fields["reserveCovered"],
+		Timestamp:      fields["currentTimestamp"],
+	}, true
+}
+
+func extractClaimFeesFields(event map[string]interface{}) (*ClaimFeesEvent, bool) {
+	requiredFields := []string{"market_id", "user", "receiver", "amount", "currentTimestamp"}
+	fields, ok := extractEventFields(event, requiredFields, []string{})
+	if !ok {
+		slog.Error("failed to extract claim fees fields", "event", event)
+		return nil, false
+	}
+
+	return &ClaimFeesEvent{
+		MarketID:  fields["market_id"],
+		User:      fields["user"],
+		Receiver:  fields["receiver"],
+		Amount:    fields["amount"],
+		Timestamp: fields["currentTimestamp"],
+	}, true
+}
+
+func extractSetCapsFields(event map[string]interface{}) (*SetCapsEvent, bool) {
+	requiredFields := []string{"market_id", "supplyCap", "borrowCap", "currentTimestamp"}
+	fields, ok := extractEventFields(event, requiredFields, []string{})
+	if !ok {
+		slog.Error("failed to extract set caps fields", "event", event)
+		return nil, false
+	}
+
+	return &SetCapsEvent{
+		MarketID:  fields["market_id"],
+		SupplyCap: fields["supplyCap"],
+		BorrowCap: fields["borrowCap"],
+		Timestamp: fields["currentTimestamp"],
+	}, true
+}
+
+func extractCircuitBreakerTrippedFields(event map[string]interface{}) (*CircuitBreakerTrippedEvent, bool) {
+	requiredFields := []string{"market_id", "reason", "spotPrice", "referencePrice", "currentTimestamp"}
+	fields, ok := extractEventFields(event, requiredFields, []string{})
+	if !ok {
+		slog.Error("failed to extract circuit breaker tripped fields", "event", event)
+		return nil, false
+	}
+
+	return &CircuitBreakerTrippedEvent{
+		MarketID:       fields["market_id"],
+		Reason:         fields["reason"],
+		SpotPrice:      fields["spotPrice"],
+		ReferencePrice: fields["referencePrice"],
+		Timestamp:      fields["currentTimestamp"],
+	}, true
+}
+
+func extractCircuitBreakerResetFields(event map[string]interface{}) (*CircuitBreakerResetEvent, bool) {
+	requiredFields := []string{"market_id", "currentTimestamp"}
+	fields, ok := extractEventFields(event, requiredFields, []string{})
+	if !ok {
+		slog.Error("failed to extract circuit breaker reset fields", "event", event)
+		return nil, false
+	}
+
+	return &CircuitBreakerResetEvent{
+		MarketID:  fields["market_id"],
+		Timestamp: fields["currentTimestamp"],
+	}, true
+}
+
+func extractSetMarketStatusFields(event map[string]interface{}) (*SetMarketStatusEvent, bool) {
+	requiredFields := []string{"supplyPaused", "borrowPaused", "liquidationPaused", "frozen", "currentTimestamp"}
+	// market_id is empty for the protocol wide status
+	fields, ok := extractEventFields(event, requiredFields, []string{"market_id"})
+	if !ok {
+		slog.Error("failed to extract set market status fields", "event", event)
+		return nil, false
+	}
+
+	return &SetMarketStatusEvent{
+		MarketID:          fields["market_id"],
+		SupplyPaused:      fields["supplyPaused"] == "true",
+		BorrowPaused:      fields["borrowPaused"] == "true",
+		LiquidationPaused: fields["liquidationPaused"] == "true",
+		Frozen:            fields["frozen"] == "true",
+		Timestamp:         fields["currentTimestamp"],
+	}, true
+}
@@ -5,11 +5,14 @@ import (
 	"volos-backend/services/dbupdater"
 
 	"cloud.google.com/go/firestore"
+	"github.com/gnolang/gno/gno.land/pkg/gnoclient"
 )
 
 // processGnoswapPoolTransaction handles transactions from the gnoswap pool package,
-// focusing only on CreatePool and Swap events, extracting the "sqrtPriceX96" attribute.
-func processGnoswapPoolTransaction(tx map[string]interface{}, firestoreClient *firestore.Client) {
+// focusing only on CreatePool and Swap events, extracting the "poolPath" attribute.
+// Market prices are read back from core, which applies each market's TWAP window or registered oracle.
+// Routed markets have no pool of their own, their oracle price is refreshed from core after a swap on a pool of their route.
+func processGnoswapPoolTransaction(tx map[string]interface{}, firestoreClient *firestore.Client, gnoClient *gnoclient.Client) {
 	events := extractEventsFromTx(tx)
 	if events == nil {
 		return
@@ -23,8 +26,9 @@ func processGnoswapPoolTransaction(tx map[string]interface{}, firestoreClient *f
 
 		switch eventType {
 		case "Swap":
-			if sqrt, poolPath, ok := extractPrice(event); ok {
-				dbupdater.UpdatePrice(firestoreClient, sqrt, poolPath)
+			if poolPath, ok := extractPoolPath(event); ok {
+				dbupdater.UpdatePrice(firestoreClient, gnoClient, poolPath)
+				dbupdater.UpdateRoutedPrices(firestoreClient, gnoClient, poolPath)
 			}
 		case "StorageDeposit":
 			continue
@@ -32,13 +36,13 @@ func processGnoswapPoolTransaction(tx map[string]interface{}, firestoreClient *f
 	}
 }
 
-// extractPrice extracts the "sqrtPriceX96" and "poolPath" attributes from an event payload.
-func extractPrice(event map[string]interface{}) (string, string, bool) {
-	fields, ok := extractEventFields(event, []string{}, []string{"sqrtPriceX96", "poolPath"})
+// extractPoolPath extracts the "poolPath" attribute from a swap event payload.
+func extractPoolPath(event map[string]interface{}) (string, bool) {
+	fields, ok := extractEventFields(event, []string{}, []string{"poolPath"})
 	if !ok {
-		slog.Error("failed to extract price", "event", event)
-		return "", "", false
+		slog.Error("failed to extract pool path", "event", event)
+		return "", false
 	}
 
-	return fields["sqrtPriceX96"], fields["poolPath"], true
+	return fields["poolPath"], true
 }
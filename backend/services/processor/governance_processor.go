@@ -8,6 +8,7 @@ package processor
 import (
 	"log/slog"
 	"strings"
+	"time"
 	"volos-backend/services/dbupdater"
 	"volos-backend/services/utils"
 
@@ -36,7 +37,7 @@ func processGovernanceTransaction(tx map[string]interface{}, client *firestore.C
 		switch eventType {
 		case "ProposalCreated":
 			if proposalEvent, ok := extractProposalFields(event); ok {
-				dbupdater.CreateProposal(client, proposalEvent.ProposalID, proposalEvent.Title, proposalEvent.Body, proposalEvent.Proposer, proposalEvent.Deadline, proposalEvent.Quorum, proposalEvent.Timestamp)
+				dbupdater.CreateProposal(client, proposalEvent.ProposalID, proposalEvent.Title, proposalEvent.Body, proposalEvent.Proposer, proposalEvent.Deadline, proposalEvent.Quorum, proposalEvent.Type, proposalEvent.Actions, proposalEvent.Timestamp)
 			}
 
 		case "ProposalExecuted":
@@ -47,11 +48,35 @@ func processGovernanceTransaction(tx map[string]interface{}, client *firestore.C
 				dbupdater.UpdateProposal(client, executedEvent.ProposalID, updates)
 			}
 
+		case "ProposalQueued":
+			if queuedEvent, ok := extractProposalQueuedFields(event); ok {
+				updates := map[string]interface{}{
+					"status": queuedEvent.Status,
+					"eta":    time.Unix(queuedEvent.ETA, 0),
+				}
+				dbupdater.UpdateProposal(client, queuedEvent.ProposalID, updates)
+			}
+
+		case "ProposalCancelled":
+			if cancelledEvent, ok := extractProposalCancelledFields(event); ok {
+				updates := map[string]interface{}{
+					"status":       cancelledEvent.Status,
+					"cancelled_by": cancelledEvent.CancelledBy,
+					"cancelled_at": time.Unix(cancelledEvent.Timestamp, 0),
+				}
+				dbupdater.UpdateProposal(client, cancelledEvent.ProposalID, updates)
+			}
+
 		case "VoteCast":
 			if voteEvent, ok := extractVoteFields(event); ok {
 				dbupdater.AddVote(client, voteEvent.ProposalID, voteEvent.Voter, voteEvent.Vote, voteEvent.Reason, voteEvent.Timestamp, voteEvent.XVLSAmount)
 			}
 
+		case "VoteOverride":
+			if overrideEvent, ok := extractVoteOverrideFields(event); ok {
+				dbupdater.AddVoteOverride(client, overrideEvent.ProposalID, overrideEvent.Staker, overrideEvent.Delegatee, overrideEvent.Vote, overrideEvent.Reason, overrideEvent.Timestamp, overrideEvent.XVLSAmount, overrideEvent.DelegateeVote, overrideEvent.DelegateeXVLSAmount)
+			}
+
 		case "MemberAdded":
 			if memberEvent, ok := extractMemberAddress(event); ok {
 				dbupdater.AddDAOMember(client, memberEvent.Member)
@@ -79,6 +104,15 @@ func processGovernanceTransaction(tx map[string]interface{}, client *firestore.C
 				}
 			}
 
+		case "Redelegate":
+			if redelegateEvent, ok := extractRedelegateFields(event); ok {
+				timestamp := utils.ParseTimestamp(redelegateEvent.Timestamp, "redelegate event")
+				if timestamp > 0 {
+					dbupdater.UpdateUserStakedVLS(client, redelegateEvent.Staker, redelegateEvent.FromDelegatee, -redelegateEvent.Amount, timestamp)
+					dbupdater.UpdateUserStakedVLS(client, redelegateEvent.Staker, redelegateEvent.ToDelegatee, redelegateEvent.Amount, timestamp)
+				}
+			}
+
 		case "Withdraw":
 			if withdrawEvent, ok := extractWithdrawnUnstakeIDs(event); ok && len(withdrawEvent.WithdrawnIDs) > 0 {
 				dbupdater.DeletePendingUnstakesByIDs(client, withdrawEvent.Staker, withdrawEvent.WithdrawnIDs)
@@ -93,7 +127,8 @@ func processGovernanceTransaction(tx map[string]interface{}, client *firestore.C
 // extractProposalFields extracts proposal fields from a transaction event
 func extractProposalFields(event map[string]interface{}) (*ProposalCreatedEvent, bool) {
 	required := []string{"proposal_id", "title", "caller", "deadline", "quorum", "timestamp", "body"}
-	fields, ok := extractEventFields(event, required, []string{})
+	optional := []string{"proposal_type", "actions"}
+	fields, ok := extractEventFields(event, required, optional)
 	if !ok {
 		slog.Error("failed to extract proposal fields", "event", event)
 		return nil, false
@@ -106,6 +141,8 @@ func extractProposalFields(event map[string]interface{}) (*ProposalCreatedEvent,
 		Proposer:   fields["caller"],
 		Deadline:   fields["deadline"],
 		Quorum:     fields["quorum"],
+		Type:       fields["proposal_type"],
+		Actions:    fields["actions"],
 		Timestamp:  fields["timestamp"],
 	}, true
 }
@@ -124,6 +161,47 @@ func extractProposalIDAndStatus(event map[string]interface{}) (*ProposalExecuted
 	}, true
 }
 
+// extractProposalQueuedFields extracts the proposal ID, status and ETA from a ProposalQueued event
+func extractProposalQueuedFields(event map[string]interface{}) (*ProposalQueuedEvent, bool) {
+	required := []string{"proposal_id", "status", "eta"}
+	fields, ok := extractEventFields(event, required, []string{})
+	if !ok {
+		slog.Error("failed to extract proposal queued fields", "event", event)
+		return nil, false
+	}
+
+	eta := utils.ParseTimestamp(fields["eta"], "proposal eta")
+	if eta == 0 {
+		return nil, false
+	}
+	return &ProposalQueuedEvent{
+		ProposalID: fields["proposal_id"],
+		Status:     fields["status"],
+		ETA:        eta,
+	}, true
+}
+
+// extractProposalCancelledFields extracts the proposal ID, status, caller and time from a ProposalCancelled event
+func extractProposalCancelledFields(event map[string]interface{}) (*ProposalCancelledEvent, bool) {
+	required := []string{"proposal_id", "status", "caller", "timestamp"}
+	fields, ok := extractEventFields(event, required, []string{})
+	if !ok {
+		slog.Error("failed to extract proposal cancelled fields", "event", event)
+		return nil, false
+	}
+
+	timestamp := utils.ParseTimestamp(fields["timestamp"], "proposal cancellation")
+	if timestamp == 0 {
+		return nil, false
+	}
+	return &ProposalCancelledEvent{
+		ProposalID:  fields["proposal_id"],
+		Status:      fields["status"],
+		CancelledBy: fields["caller"],
+		Timestamp:   timestamp,
+	}, true
+}
+
 // extractVoteFields extracts vote fields from a VoteCast event
 func extractVoteFields(event map[string]interface{}) (*VoteCastEvent, bool) {
 	required := []string{"proposal_id", "voter", "vote", "xvls_amount", "timestamp"}
@@ -149,6 +227,33 @@ func extractVoteFields(event map[string]interface{}) (*VoteCastEvent, bool) {
 	}, true
 }
 
+// extractVoteOverrideFields extracts vote override fields from a VoteOverride event
+func extractVoteOverrideFields(event map[string]interface{}) (*VoteOverrideEvent, bool) {
+	required := []string{"proposal_id", "staker", "delegatee", "vote", "xvls_amount", "delegatee_xvls_amount", "timestamp"}
+	optional := []string{"reason", "delegatee_vote"}
+	fields, ok := extractEventFields(event, required, optional)
+	if !ok {
+		slog.Error("failed to extract vote override fields", "event", event)
+		return nil, false
+	}
+
+	amt := utils.ParseInt64(fields["xvls_amount"], "override xVLS amount")
+	if amt == 0 {
+		return nil, false
+	}
+	return &VoteOverrideEvent{
+		ProposalID:          fields["proposal_id"],
+		Staker:              fields["staker"],
+		Delegatee:           fields["delegatee"],
+		Vote:                fields["vote"],
+		Reason:              fields["reason"],
+		XVLSAmount:          amt,
+		DelegateeVote:       fields["delegatee_vote"],
+		DelegateeXVLSAmount: utils.ParseInt64(fields["delegatee_xvls_amount"], "delegatee xVLS amount"),
+		Timestamp:           fields["timestamp"],
+	}, true
+}
+
 // extractMemberAddress extracts the member address from MemberAdded/MemberRemoved events
 func extractMemberAddress(event map[string]interface{}) (*MemberEvent, bool) {
 	required := []string{"member"}
@@ -213,6 +318,29 @@ func extractBeginUnstakeFields(event map[string]interface{}) (*BeginUnstakeEvent
 	}, true
 }
 
+// extractRedelegateFields extracts fields from a Redelegate event
+func extractRedelegateFields(event map[string]interface{}) (*RedelegateEvent, bool) {
+	required := []string{"staker", "from_delegatee", "to_delegatee", "amount", "timestamp"}
+	fields, ok := extractEventFields(event, required, []string{})
+	if !ok {
+		slog.Error("failed to extract redelegate fields", "event", event)
+		return nil, false
+	}
+
+	amt := utils.ParseInt64(fields["amount"], "redelegate amount")
+	if amt == 0 {
+		return nil, false
+	}
+
+	return &RedelegateEvent{
+		Staker:        fields["staker"],
+		FromDelegatee: fields["from_delegatee"],
+		ToDelegatee:   fields["to_delegatee"],
+		Amount:        amt,
+		Timestamp:     fields["timestamp"],
+	}, true
+}
+
 // extractWithdrawnUnstakeIDs extracts the staker and the list of withdrawn unstake IDs from a Withdraw event
 func extractWithdrawnUnstakeIDs(event map[string]interface{}) (*GovernanceWithdrawEvent, bool) {
 	required := []string{"staker", "withdrawn_unstake_ids"}
@@ -153,5 +153,5 @@ func (q *TransactionProcessorQueue) Submit(tx map[string]interface{}) {
 func ProcessTransaction(tx map[string]interface{}, firestoreClient *firestore.Client, gnoClient *gnoclient.Client) {
 	processCoreTransaction(tx, firestoreClient, gnoClient)
 	processGovernanceTransaction(tx, firestoreClient)
-	processGnoswapPoolTransaction(tx, firestoreClient)
+	processGnoswapPoolTransaction(tx, firestoreClient, gnoClient)
 }
@@ -4,9 +4,12 @@ package processor
 
 type CreateMarketEvent struct {
 	MarketID                string
+	PoolPath                string
 	LoanToken               string
 	CollateralToken         string
 	IsToken0Loan            string
+	IRM                     string
+	Oracle                  string
 	LoanTokenName           string
 	LoanTokenSymbol         string
 	LoanTokenDecimals       string
@@ -68,16 +71,19 @@ type RepayEvent struct {
 }
 
 type LiquidateEvent struct {
-	MarketID    string
-	User        string
-	Borrower    string
-	Amount      string
-	Shares      string
-	Seized      string
-	Timestamp   string
-	SupplyAPR   string
-	BorrowAPR   string
-	Utilization string
+	MarketID       string
+	User           string
+	Borrower       string
+	Amount         string
+	Shares         string
+	Seized         string
+	Timestamp      string
+	SupplyAPR      string
+	BorrowAPR      string
+	Utilization    string
+	BadDebtAssets  string
+	BadDebtShares  string
+	ReserveCovered string
 }
 
 type SupplyCollateralEvent struct {
@@ -109,6 +115,19 @@ type SetFeeEvent struct {
 	Timestamp string
 }
 
+type CircuitBreakerTrippedEvent struct {
+	MarketID       string
+	Reason         string
+	SpotPrice      string
+	ReferencePrice string
+	Timestamp      string
+}
+
+type CircuitBreakerResetEvent struct {
+	MarketID  string
+	Timestamp string
+}
+
 // governance events
 
 type ProposalCreatedEvent struct {
@@ -118,6 +137,8 @@ type ProposalCreatedEvent struct {
 	Proposer   string
 	Deadline   string
 	Quorum     string
+	Type       string
+	Actions    string
 	Timestamp  string
 }
 
@@ -126,6 +147,19 @@ type ProposalExecutedEvent struct {
 	Status     string
 }
 
+type ProposalQueuedEvent struct {
+	ProposalID string
+	Status     string
+	ETA        int64
+}
+
+type ProposalCancelledEvent struct {
+	ProposalID  string
+	Status      string
+	CancelledBy string
+	Timestamp   int64
+}
+
 type VoteCastEvent struct {
 	ProposalID string
 	Voter      string
@@ -135,6 +169,18 @@ type VoteCastEvent struct {
 	Timestamp  string
 }
 
+type VoteOverrideEvent struct {
+	ProposalID          string
+	Staker              string
+	Delegatee           string
+	Vote                string
+	Reason              string
+	XVLSAmount          int64
+	DelegateeVote       string
+	DelegateeXVLSAmount int64
+	Timestamp           string
+}
+
 type MemberEvent struct {
 	Member string
 }
@@ -156,6 +202,14 @@ type BeginUnstakeEvent struct {
 	UnstakeID string
 }
 
+type RedelegateEvent struct {
+	Staker        string
+	FromDelegatee string
+	ToDelegatee   string
+	Amount        int64
+	Timestamp     string
+}
+
 type GovernanceWithdrawEvent struct {
 	Staker       string
 	WithdrawnIDs []string
@@ -169,3 +223,38 @@ type TxMetadata struct {
 	BlockHeight float64
 	Index       float64
 }
+
+type SetMarketStatusEvent struct {
+	MarketID          string
+	SupplyPaused      bool
+	BorrowPaused      bool
+	LiquidationPaused bool
+	Frozen            bool
+	Timestamp         string
+}
+
+type SetCapsEvent struct {
+	MarketID  string
+	SupplyCap string
+	BorrowCap string
+	Timestamp string
+}
+
+type RealizeBadDebtEvent struct {
+	MarketID       string
+	User           string
+	Borrower       string
+	Seized         string
+	BadDebtAssets  string
+	BadDebtShares  string
+	ReserveCovered string
+	Timestamp      string
+}
+
+type ClaimFeesEvent struct {
+	MarketID  string
+	User      string
+	Receiver  string
+	Amount    string
+	Timestamp string
+}
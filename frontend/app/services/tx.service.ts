@@ -224,7 +224,7 @@ export class TxService {
     return await this.broadcast(tx);
   }
 
-  public async createMarket(poolPath: string, isToken0Loan: boolean, irm: string, lltv: number) {
+  public async createMarket(poolPath: string, isToken0Loan: boolean, irm: string, lltv: number, twapWindow: number, oracle: string) {
     const adenaService = this.ensureWalletConnected();
 
     const tx = TransactionBuilder.create()
@@ -234,7 +234,7 @@ export class TxService {
           send: "",
           pkg_path: VOLOS_PKG_PATH,
           func: "CreateMarket",
-          args: [poolPath, isToken0Loan.toString(), irm, lltv.toString()],
+          args: [poolPath, isToken0Loan.toString(), irm, lltv.toString(), twapWindow.toString(), oracle],
           max_deposit: ""
         })
       )
@@ -410,6 +410,58 @@ export class TxService {
     return await this.broadcast(tx);
   }
 
+  /**
+   * Move staked VLS from one delegatee to another without the unstake cooldown
+   * Fails while the old delegatee has votes on active proposals
+   */
+  public async redelegateVLS(from: string, to: string, amount: number) {
+    const adenaService = this.ensureWalletConnected();
+
+    const tx = TransactionBuilder.create()
+      .messages(
+        makeMsgCallMessage({
+          caller: adenaService.getAddress(),
+          send: "",
+          pkg_path: STAKER_PKG_PATH,
+          func: "Redelegate",
+          args: [from, to, amount.toString()],
+          max_deposit: ""
+        })
+      )
+      .fee(1000000, 'ugnot')
+      .gasWanted(GAS_WANTED)
+      .memo("")
+      .build();
+
+    return await this.broadcast(tx);
+  }
+
+  /**
+   * Claim the staking rewards of the connected wallet, in every reward token
+   * Rewards go to the staker, not to its delegatees
+   */
+  public async claimStakingRewards() {
+    const adenaService = this.ensureWalletConnected();
+
+    const tx = TransactionBuilder.create()
+      .messages(
+        makeMsgCallMessage({
+          caller: adenaService.getAddress(),
+          send: "",
+          pkg_path: STAKER_PKG_PATH,
+          func: "ClaimRewards",
+          args: [],
+          max_deposit: ""
+        })
+      )
+      .fee(1000000, 'ugnot')
+      .gasWanted(GAS_WANTED)
+      .memo("")
+      .build();
+
+    return await this.broadcast(tx);
+  }
+
   /**
    * Withdraw matured VLS unstakes from the staker contract
    * This completes the unstaking process after the cooldown period
@@ -459,9 +511,35 @@ export class TxService {
     return await this.broadcast(tx);
   }
 
+  /**
+   * Queue a proposal by ID
+   * Calls the governance Queue function to tally the proposal and queue it behind the timelock if it has passed
+   */
+  public async queueProposal(proposalId: string) {
+    const adenaService = this.ensureWalletConnected();
+
+    const tx = TransactionBuilder.create()
+      .messages(
+        makeMsgCallMessage({
+          caller: adenaService.getAddress(),
+          send: "",
+          pkg_path: GOVERNANCE_PKG_PATH,
+          func: "Queue",
+          args: [proposalId],
+          max_deposit: ""
+        })
+      )
+      .fee(100000, 'ugnot')
+      .gasWanted(GAS_WANTED)
+      .memo("")
+      .build();
+
+    return await this.broadcast(tx);
+  }
+
   /**
    * Execute a proposal by ID
-   * Calls the governance Execute function to enact the proposal if it has passed
+   * Calls the governance Execute function to enact a queued proposal once its timelock expired
    */
   public async executeProposal(proposalId: string) {
     const adenaService = this.ensureWalletConnected();
@@ -485,5 +563,31 @@ export class TxService {
     return await this.broadcast(tx);
   }
 
+  /**
+   * Cancel a proposal by ID
+   * Calls the governance CancelProposal function, allowed for the proposer or once the proposer fell below the proposal threshold
+   */
+  public async cancelProposal(proposalId: string) {
+    const adenaService = this.ensureWalletConnected();
+
+    const tx = TransactionBuilder.create()
+      .messages(
+        makeMsgCallMessage({
+          caller: adenaService.getAddress(),
+          send: "",
+          pkg_path: GOVERNANCE_PKG_PATH,
+          func: "CancelProposal",
+          args: [proposalId],
+          max_deposit: ""
+        })
+      )
+      .fee(100000, 'ugnot')
+      .gasWanted(GAS_WANTED)
+      .memo("")
+      .build();
+
+    return await this.broadcast(tx);
+  }
+
   
 }
@@ -342,14 +342,18 @@ export function useCreateMarketMutation() {
         marketId,
         isToken0Loan,
         irm,
-        lltv
+        lltv,
+        twapWindow,
+        oracle
       }: { 
         marketId: string;
         isToken0Loan: boolean;
         irm: string;
         lltv: number;
+        twapWindow: number;
+        oracle: string;
       }) => {
-        return txService.createMarket(marketId, isToken0Loan, irm, lltv);
+        return txService.createMarket(marketId, isToken0Loan, irm, lltv, twapWindow, oracle);
       },
       onMutate: async () => {
         await queryClient.cancelQueries({ queryKey: [MARKETS_QUERY_KEY] });
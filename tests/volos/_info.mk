@@ -13,4 +13,13 @@ ADDR_XVLS := g1hdpp5djpfj899j9t0kmezs4ke60k0nxhxnydq9 # std.DerivePkgAddr("gno.l
 ADDR_STAKER := g1xgaa5n8qtgl6z97aug8nvrtvm0l9ahvtghru5l # std.DerivePkgAddr("gno.land/r/volos/gov/staker")
 ADDR_GOVERNANCE := g1kp52puf7vuqptdg2kdqjmy45v70sh2s6g984f8 # std.DerivePkgAddr("gno.land/r/volos/gov/governance")
 
+ADDR_MOCKS := g15e0438e634625eqs8nxns6l2sw89lxaw983cp9 # std.DerivePkgAddr("gno.land/r/volos/mocks")
+
 MAX_APPROVE := 9223372036854775806
+
+# Market IDs are hashes of the full market params, so they are queried from core when used
+COMMA := ,
+qeval_string = $(shell gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data '$(1)' | sed -n 's/^data: ("\(.*\)" string)$$/\1/p')
+GNS_WUGNOT_MARKET_ID = $(call qeval_string,gno.land/r/volos/core.GetMarketID("gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000"$(COMMA) false$(COMMA) "kink"$(COMMA) 75$(COMMA) 0$(COMMA) ""))
+BAR_WUGNOT_MARKET_ID = $(call qeval_string,gno.land/r/volos/core.GetMarketID("gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000"$(COMMA) false$(COMMA) "linear"$(COMMA) 75$(COMMA) 0$(COMMA) ""))
+GNS_BAR_ROUTED_MARKET_ID = $(call qeval_string,gno.land/r/volos/core.GetRoutedMarketID("gno.land/r/gnoswap/v1/gns"$(COMMA) "gno.land/r/gnoswap/v1/test_token/bar"$(COMMA) "kink"$(COMMA) 75$(COMMA) "bar-wugnot-gns"))
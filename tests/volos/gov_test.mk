@@ -58,6 +58,12 @@ create-test-proposal:
 	@sleep 3
 	@echo
 
+# Create a proposal with typed actions, voted for 60s
+create-action-proposal:
+	$(info ************ Create action proposal ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/gov/governance -func CreateActionProposal -args "Shorten unstake cooldown" -args "Set the unstake lock period to 1 day" -args 60000000000 -args '[{"kind":"SetUnstakeLockPeriod","seconds":86400}]' -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
 # Vote yes on the proposal
 vote-yes:
 	$(info ************ Vote yes on proposal ************)
@@ -146,12 +152,30 @@ vote-all-on-all-proposals:
 	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/gov/governance -func Vote -args 3 -args "YES" -args "ADDR_USER_3 supports proposal 3" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" $(ADDR_USER_3)
 	@echo
 
-# Execute the proposal
+# Queue the proposal once its voting period is over
+queue-proposal:
+	$(info ************ Queue proposal ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/gov/governance -func Queue -args 1 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Execute the proposal once its timelock expired
 execute-proposal:
 	$(info ************ Execute proposal ************)
 	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/gov/governance -func Execute -args 1 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
+# Claim the staking rewards of the admin
+claim-rewards:
+	$(info ************ Claim staking rewards ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/gov/staker -func ClaimRewards -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Check the staking rewards the admin can claim
+check-pending-rewards:
+	$(info ************ Check pending staking rewards ************)
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/gov/staker.ApiGetPendingRewards(\"$(ADMIN)\")"
+	@echo
+
 # Check VLS balance
 check-vls-balance:
 	$(info ************ Check VLS balance ************)
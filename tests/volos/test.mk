@@ -34,53 +34,103 @@ transfer-ownership:
 	@echo
 
 # Test market creation with GNS and WUGNOT
+# The workflow markets use the spot price so they can borrow right away, a TWAP market only
+# prices once its history covers a full window
 market-create-gns-wugnot:
 	$(info ************ Test creating market with GNS (supply/borrow) and WUGNOT (collateral) ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func CreateMarket -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000" -args false -args "kink" -args 75 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func CreateMarket -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000" -args false -args "kink" -args 75 -args 0 -args "" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 set-fee-gns-wugnot:
 	$(info ************ Testing Set Fee to 30% ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetFee -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 30 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetFee -args "$(GNS_WUGNOT_MARKET_ID)" -args 30 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test market creation with GNS and WUGNOT
 market-create-bar-wugnot:
 	$(info ************ Test creating market with BAR (supply/borrow) and WUGNOT (collateral) ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func CreateMarket -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000" -args false -args "linear" -args 75 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func CreateMarket -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000" -args false -args "linear" -args 75 -args 0 -args "" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Register a route oracle pricing BAR in GNS through WUGNOT, with a 30 minutes TWAP on each hop
+register-route-oracle-bar-gns:
+	$(info ************ Register route oracle BAR -> WUGNOT -> GNS ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func RegisterRouteOracle -args "bar-wugnot-gns" -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000,gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000" -args 1800 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Enable the route oracle (must run before transfer-ownership)
+enable-route-oracle-bar-gns:
+	$(info ************ Enable route oracle BAR -> WUGNOT -> GNS ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func EnableOracle -args "bar-wugnot-gns" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Test routed market creation with GNS (loan) and BAR (collateral), no direct pool
+market-create-gns-bar-routed:
+	$(info ************ Test creating routed market with GNS (supply/borrow) and BAR (collateral) ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func CreateRoutedMarket -args "gno.land/r/gnoswap/v1/gns" -args "gno.land/r/gnoswap/v1/test_token/bar" -args "kink" -args 75 -args "bar-wugnot-gns" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Test getting the routed price for the GNS-BAR market
+market-get-price-gns-bar-routed:
+	$(info ************ Test getting routed price for GNS-BAR market ************)
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetMarketPrice(\"$(GNS_BAR_ROUTED_MARKET_ID)\")"
+	@echo
+
+# Set oracle guards on the GNS-WUGNOT market: 10% max deviation, no liquidity floor, 1 hour max reference age (must run before transfer-ownership)
+set-oracle-guard-gns-wugnot:
+	$(info ************ Set oracle guard on GNS-WUGNOT market ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetOracleGuard -args "$(GNS_WUGNOT_MARKET_ID)" -args 10 -args "0" -args 3600 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Set liquidation params on the GNS-WUGNOT market: 0.3 cursor, 1.1 max incentive, 50% close factor above 0.95 health (must run before transfer-ownership)
+set-liquidation-params-gns-wugnot:
+	$(info ************ Set liquidation params on GNS-WUGNOT market ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetLiquidationParams -args "$(GNS_WUGNOT_MARKET_ID)" -args 3000 -args 11000 -args 5000 -args 9500 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Send half of the GNS-WUGNOT market fee to its reserve (must run before transfer-ownership)
+set-reserve-factor-gns-wugnot:
+	$(info ************ Set reserve factor on GNS-WUGNOT market ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetReserveFactor -args "$(GNS_WUGNOT_MARKET_ID)" -args 50 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Run the oracle guards of the GNS-WUGNOT market
+check-oracle-gns-wugnot:
+	$(info ************ Check oracle of GNS-WUGNOT market ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func CheckOracle -args "$(GNS_WUGNOT_MARKET_ID)" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test getting pool price for GNS-WUGNOT market
 market-get-price-gns-wugnot:
 	$(info ************ Test getting pool price for GNS-WUGNOT market ************)
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetMarketPrice(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetMarketPrice(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 # Test getting market info for GNS-WUGNOT pair
 market-get-gns-wugnot:
 	$(info ************ Test getting market info for GNS-WUGNOT pair ************)
 	# GET TOTAL SUPPLY ASSETS
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalSupplyAssets(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalSupplyAssets(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 	# GET TOTAL SUPPLY SHARES
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalSupplyShares(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalSupplyShares(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 	# GET TOTAL BORROW ASSETS
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalBorrowAssets(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalBorrowAssets(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 	# GET TOTAL BORROW SHARES
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalBorrowShares(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetTotalBorrowShares(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 	# GET LIQUIDATION LTV
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetLLTV(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetLLTV(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 	# GET MARKET FEE
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetFee(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetFee(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 # Test supplying assets to GNS-WUGNOT market
@@ -91,7 +141,7 @@ supply-assets-gns-wugnot:
 	@echo
 
 	# THEN SUPPLY
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Supply -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 148000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Supply -args "$(GNS_WUGNOT_MARKET_ID)" -args 148000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test supplying shares to GNS-WUGNOT market
@@ -102,28 +152,28 @@ supply-shares-gns-wugnot:
 	@echo
 
 	# THEN SUPPLY
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Supply -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 0 -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Supply -args "$(GNS_WUGNOT_MARKET_ID)" -args 0 -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test withdrawing assets from GNS-WUGNOT market
 withdraw-assets-gns-wugnot:
 	$(info ************ Test withdrawing GNS assets from GNS-WUGNOT market ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Withdraw -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 994940 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Withdraw -args "$(GNS_WUGNOT_MARKET_ID)" -args 994940 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Check user position in GNS-WUGNOT market
 check-position-gns-wugnot:
 	$(info ************ Check user position in GNS-WUGNOT market ************)
 	# Check supply shares
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionSupplyShares(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\", \"$(ADMIN)\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionSupplyShares(\"$(GNS_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
 	@echo
 
 	# Check borrow shares
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionBorrowShares(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\", \"$(ADMIN)\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionBorrowShares(\"$(GNS_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
 	@echo
 
 	# Check collateral
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionCollateral(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\", \"$(ADMIN)\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionCollateral(\"$(GNS_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
 	@echo
 
 # Check GNS balance
@@ -147,7 +197,7 @@ check-volos-wugnot-balance:
 # Test accruing interest on GNS-WUGNOT market
 accrue-interest-gns-wugnot:
 	$(info ************ Test accruing interest on GNS-WUGNOT market ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func AccrueInterest -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func AccrueInterest -args "$(GNS_WUGNOT_MARKET_ID)" -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Check WUGNOT balance
@@ -174,7 +224,7 @@ wrap-ugnot-all:
 # Test borrowing GNS tokens
 borrow-gns:
 	$(info ************ Test borrowing GNS tokens ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Borrow -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 74000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Borrow -args "$(GNS_WUGNOT_MARKET_ID)" -args 74000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test repaying GNS tokens
@@ -185,7 +235,7 @@ repay-gns:
 	@echo
 
 	# THEN REPAY
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Repay -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 37000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Repay -args "$(GNS_WUGNOT_MARKET_ID)" -args 37000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test liquidating GNS position
@@ -196,7 +246,7 @@ liquidate-gns:
 	@echo
 
 	# THEN LIQUIDATE
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Liquidate -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args $(ADMIN) -args 0 -args 25 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Liquidate -args "$(GNS_WUGNOT_MARKET_ID)" -args $(ADMIN) -args 0 -args 25 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test supplying collateral to GNS-WUGNOT market
@@ -211,13 +261,13 @@ supply-collateral-gns-wugnot:
 	@echo
 
 	# THEN SUPPLY COLLATERAL
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SupplyCollateral -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SupplyCollateral -args "$(GNS_WUGNOT_MARKET_ID)" -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test withdrawing collateral from GNS-WUGNOT market
 withdraw-collateral-gns-wugnot:
 	$(info ************ Test withdrawing collateral from GNS-WUGNOT market ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func WithdrawCollateral -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 500 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func WithdrawCollateral -args "$(GNS_WUGNOT_MARKET_ID)" -args 500 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test supplying assets to BAR-WUGNOT market
@@ -232,7 +282,7 @@ supply-assets-bar-wugnot:
 	@echo
 
 	# THEN SUPPLY
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Supply -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000:0" -args 1000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Supply -args "$(BAR_WUGNOT_MARKET_ID)" -args 1000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test supplying collateral to BAR-WUGNOT market
@@ -243,32 +293,32 @@ supply-collateral-bar-wugnot:
 	@echo
 
 	# THEN SUPPLY COLLATERAL
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SupplyCollateral -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000:0" -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SupplyCollateral -args "$(BAR_WUGNOT_MARKET_ID)" -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Test borrowing BAR tokens
 borrow-bar:
 	$(info ************ Test borrowing BAR tokens ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Borrow -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000:0" -args 500000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Borrow -args "$(BAR_WUGNOT_MARKET_ID)" -args 500000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
 	@echo
 
 # Check user position in BAR-WUGNOT market
 check-position-bar-wugnot:
 	$(info ************ Check user position in BAR-WUGNOT market ************)
 	# Check supply shares
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionSupplyShares(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000:0\", \"$(ADMIN)\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionSupplyShares(\"$(BAR_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
 	@echo
 
 	# Check borrow shares
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionBorrowShares(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000:0\", \"$(ADMIN)\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionBorrowShares(\"$(BAR_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
 	@echo
 
 	# Check collateral
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionCollateral(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000:0\", \"$(ADMIN)\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPositionCollateral(\"$(BAR_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
 	@echo
 
 	# Check health factor
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetHealthFactor(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/test_token/bar:3000:0\", \"$(ADMIN)\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetHealthFactor(\"$(BAR_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
 	@echo
 
 # Check volos owner
@@ -277,12 +327,145 @@ check-volos-owner:
 	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetOwner()"
 	@echo
 
+# Set a 0.09% flash loan fee shared between suppliers (must run before transfer-ownership)
+set-flash-loan-fee:
+	$(info ************ Set flash loan fee to 9 bps ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetFlashLoanFee -args 9 -args true -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
 # Test flash loan
 test-flashloan:
 	$(info ************ Testing Flash Loan ************)
 	@echo "" | gnokey maketx run gnoswap_admin flashloan.gno -gas-wanted 200000000 -gas-fee 1000000ugnot -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID)
 	@echo
 
+# Test multicall (supply collateral and borrow in one transaction)
+test-multicall:
+	$(info ************ Testing Multicall ************)
+	@echo "" | gnokey maketx run gnoswap_admin multicall.gno -gas-wanted 200000000 -gas-fee 1000000ugnot -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID)
+	@echo
+
+# Test multicall with a callback step (the mocks realm supplies collateral, borrows, then supplies the collateral prepared in the callback)
+test-multicall-callback:
+	$(info ************ Testing Multicall with a callback step ************)
+	# FUND THE MOCK WITH COLLATERAL
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/demo/wugnot -func Transfer -args $(ADDR_MOCKS) -args 20000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func Leverage -args "$(GNS_WUGNOT_MARKET_ID)" -args 10000 -args 5000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# A Multicall cannot borrow past the LLTV and liquidate its own position from a callback step (the mocks realm tries it)
+test-multicall-self-liquidation:
+	$(info ************ Test self-liquidation inside a Multicall ************)
+	# FUND THE MOCK WITH COLLATERAL
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/demo/wugnot -func Transfer -args $(ADDR_MOCKS) -args 10000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func SelfLiquidate -args "$(GNS_WUGNOT_MARKET_ID)" -args 10000 -args 9000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin && exit 1 || echo "rejected as expected"
+	@echo
+
+# Test the supply, repay, supply collateral and liquidate callbacks through the callback mock
+# The mock holds the tokens it supplies, so each target funds it first. Run after full-workflow, the liquidation
+# targets need the GNS position to be liquidatable as for liquidate-gns
+test-callbacks: test-supply-with-callback test-repay-with-callback test-supply-collateral-with-callback test-liquidate-with-callback test-liquidate-just-in-time
+	@echo "************ CALLBACK TESTS FINISHED ************"
+
+# Test supplying GNS to the GNS-WUGNOT market with a callback
+test-supply-with-callback:
+	$(info ************ Testing SupplyWithCallback ************)
+	# FUND THE MOCK
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/gnoswap/v1/gns -func Transfer -args $(ADDR_MOCKS) -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func SupplyWithCallback -args "$(GNS_WUGNOT_MARKET_ID)" -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Test repaying GNS to the GNS-WUGNOT market with a callback
+test-repay-with-callback:
+	$(info ************ Testing RepayWithCallback ************)
+	# FUND THE MOCK
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/gnoswap/v1/gns -func Transfer -args $(ADDR_MOCKS) -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func RepayWithCallback -args "$(GNS_WUGNOT_MARKET_ID)" -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Test supplying WUGNOT collateral to the GNS-WUGNOT market with a callback
+test-supply-collateral-with-callback:
+	$(info ************ Testing SupplyCollateralWithCallback ************)
+	# FUND THE MOCK
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/demo/wugnot -func Transfer -args $(ADDR_MOCKS) -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func SupplyCollateralWithCallback -args "$(GNS_WUGNOT_MARKET_ID)" -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Test liquidating the GNS position with a callback, the mock repays with GNS it holds
+test-liquidate-with-callback:
+	$(info ************ Testing LiquidateWithCallback ************)
+	# FUND THE MOCK
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/gnoswap/v1/gns -func Transfer -args $(ADDR_MOCKS) -args 1000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func LiquidateWithCallback -args "$(GNS_WUGNOT_MARKET_ID)" -args $(ADMIN) -args 25 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Test a just-in-time liquidation of the GNS position, the callback pulls the repayment from the caller
+test-liquidate-just-in-time:
+	$(info ************ Testing just-in-time LiquidateWithCallback ************)
+	# APPROVE THE MOCK FIRST
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/gnoswap/v1/gns -func Approve -args $(ADDR_MOCKS) -args $(MAX_APPROVE) -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func LiquidateJustInTime -args "$(GNS_WUGNOT_MARKET_ID)" -args $(ADMIN) -args 25 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# Test pre-liquidation of the GNS position by the mocks realm. Run after full-workflow: the position borrows 74%
+# of its collateral value, above the 70% pre-LLTV and below the 75% LLTV
+test-preliquidation: set-preliquidation-gns-wugnot test-preliquidator-not-authorized set-preliquidator-mocks test-preliquidator-cannot-borrow test-preliquidate-gns
+	@echo "************ PRE-LIQUIDATION TESTS FINISHED ************"
+
+# Opt the GNS position into pre-liquidation: 70% pre-LLTV, 10% of the debt per call, 2% bonus
+set-preliquidation-gns-wugnot:
+	$(info ************ Set pre-liquidation on the GNS position ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetPreLiquidation -args "$(GNS_WUGNOT_MARKET_ID)" -args 70 -args 1000 -args 10200 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+# A pre-liquidator the borrower did not authorize is rejected
+test-preliquidator-not-authorized:
+	$(info ************ Test pre-liquidation by an unauthorized address ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func PreLiquidate -args "$(GNS_WUGNOT_MARKET_ID)" -args $(ADMIN) -args 1000 -args $(MAX_APPROVE) -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin && exit 1 || echo "rejected as expected"
+	@echo
+
+# Authorize the mocks realm as pre-liquidator of the admin's positions
+set-preliquidator-mocks:
+	$(info ************ Authorize the mocks realm as pre-liquidator ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func SetPreLiquidator -args $(ADDR_MOCKS) -args true -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetIsPreLiquidator(\"$(ADMIN)\", \"$(ADDR_MOCKS)\")"
+	@echo
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetIsAuthorized(\"$(ADMIN)\", \"$(ADDR_MOCKS)\")"
+	@echo
+
+# A pre-liquidator cannot borrow on behalf of the borrower
+test-preliquidator-cannot-borrow:
+	$(info ************ Test borrowing on behalf of the borrower as pre-liquidator ************)
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func BorrowOnBehalf -args "$(GNS_WUGNOT_MARKET_ID)" -args $(ADMIN) -args 1000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin && exit 1 || echo "rejected as expected"
+	@echo
+
+# Pre-liquidate a slice of the GNS position, the mock repays with GNS it holds
+test-preliquidate-gns:
+	$(info ************ Test pre-liquidating the GNS position ************)
+	# FUND THE MOCK
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/gnoswap/v1/gns -func Transfer -args $(ADDR_MOCKS) -args 1000000000 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/mocks -func PreLiquidate -args "$(GNS_WUGNOT_MARKET_ID)" -args $(ADMIN) -args 1000 -args $(MAX_APPROVE) -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" gnoswap_admin
+	@echo
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetPosition(\"$(GNS_WUGNOT_MARKET_ID)\", \"$(ADMIN)\")"
+	@echo
+
 # Get Volos contract address derived from path
 get-volos-address:
 	$(info ************ Getting Volos Contract Address ************)
@@ -292,17 +475,17 @@ get-volos-address:
 # Test calculate borrow APR for GNS-WUGNOT market
 test-calculate-borrow-apr:
 	$(info ************ Testing Calculate Borrow APR ************)
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.CalculateBorrowAPR(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.CalculateBorrowAPR(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 # Get borrow rate for GNS-WUGNOT market
 get-borrow-rate-gns-wugnot:
 	$(info ************ Get Borrow Rate for GNS-WUGNOT Market ************)
-	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetBorrowRate(\"gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0\")"
+	gnokey query vm/qeval -remote $(GNOLAND_RPC_URL) -data "gno.land/r/volos/core.GetBorrowRate(\"$(GNS_WUGNOT_MARKET_ID)\")"
 	@echo
 
 # Withdraw supply for GNS-WUGNOT market for specific user
 withdraw-supply-gns-wugnot-user:
 	$(info ************ Withdraw Supply for GNS-WUGNOT Market - User g1tzl3sgre0c2zgxfpws9xhq0c069wf7zqh6aqqy ************)
-	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Withdraw -args "gno.land/r/demo/wugnot:gno.land/r/gnoswap/v1/gns:3000:0" -args 2000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" g1tzl3sgre0c2zgxfpws9xhq0c069wf7zqh6aqqy
+	@echo "" | gnokey maketx call -pkgpath gno.land/r/volos/core -func Withdraw -args "$(GNS_WUGNOT_MARKET_ID)" -args 2000000000 -args 0 -insecure-password-stdin=true -remote $(GNOLAND_RPC_URL) -broadcast=true -chainid $(CHAINID) -gas-fee 100000000ugnot -gas-wanted 1000000000 -memo "" g1tzl3sgre0c2zgxfpws9xhq0c069wf7zqh6aqqy
 	@echo